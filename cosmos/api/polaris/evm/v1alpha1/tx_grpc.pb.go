@@ -22,6 +22,8 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	MsgService_EthTransaction_FullMethodName         = "/polaris.evm.v1alpha1.MsgService/EthTransaction"
 	MsgService_ProcessPayloadEnvelope_FullMethodName = "/polaris.evm.v1alpha1.MsgService/ProcessPayloadEnvelope"
+	MsgService_UpdateParams_FullMethodName           = "/polaris.evm.v1alpha1.MsgService/UpdateParams"
+	MsgService_ScheduleHardfork_FullMethodName       = "/polaris.evm.v1alpha1.MsgService/ScheduleHardfork"
 )
 
 // MsgServiceClient is the client API for MsgService service.
@@ -32,6 +34,10 @@ type MsgServiceClient interface {
 	EthTransaction(ctx context.Context, in *WrappedEthereumTransaction, opts ...grpc.CallOption) (*WrappedEthereumTransactionResult, error)
 	// ProcessPayloadEnvelope defines a method to process CL paylods.
 	ProcessPayloadEnvelope(ctx context.Context, in *WrappedPayloadEnvelope, opts ...grpc.CallOption) (*WrappedPayloadEnvelopeResponse, error)
+	// UpdateParams defines a governance operation for updating the EVM chain config.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ScheduleHardfork defines a governance operation for scheduling a time based hardfork.
+	ScheduleHardfork(ctx context.Context, in *MsgScheduleHardfork, opts ...grpc.CallOption) (*MsgScheduleHardforkResponse, error)
 }
 
 type msgServiceClient struct {
@@ -60,6 +66,24 @@ func (c *msgServiceClient) ProcessPayloadEnvelope(ctx context.Context, in *Wrapp
 	return out, nil
 }
 
+func (c *msgServiceClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, MsgService_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) ScheduleHardfork(ctx context.Context, in *MsgScheduleHardfork, opts ...grpc.CallOption) (*MsgScheduleHardforkResponse, error) {
+	out := new(MsgScheduleHardforkResponse)
+	err := c.cc.Invoke(ctx, MsgService_ScheduleHardfork_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 // All implementations must embed UnimplementedMsgServiceServer
 // for forward compatibility
@@ -68,6 +92,10 @@ type MsgServiceServer interface {
 	EthTransaction(context.Context, *WrappedEthereumTransaction) (*WrappedEthereumTransactionResult, error)
 	// ProcessPayloadEnvelope defines a method to process CL paylods.
 	ProcessPayloadEnvelope(context.Context, *WrappedPayloadEnvelope) (*WrappedPayloadEnvelopeResponse, error)
+	// UpdateParams defines a governance operation for updating the EVM chain config.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ScheduleHardfork defines a governance operation for scheduling a time based hardfork.
+	ScheduleHardfork(context.Context, *MsgScheduleHardfork) (*MsgScheduleHardforkResponse, error)
 	mustEmbedUnimplementedMsgServiceServer()
 }
 
@@ -81,6 +109,12 @@ func (UnimplementedMsgServiceServer) EthTransaction(context.Context, *WrappedEth
 func (UnimplementedMsgServiceServer) ProcessPayloadEnvelope(context.Context, *WrappedPayloadEnvelope) (*WrappedPayloadEnvelopeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessPayloadEnvelope not implemented")
 }
+func (UnimplementedMsgServiceServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServiceServer) ScheduleHardfork(context.Context, *MsgScheduleHardfork) (*MsgScheduleHardforkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleHardfork not implemented")
+}
 func (UnimplementedMsgServiceServer) mustEmbedUnimplementedMsgServiceServer() {}
 
 // UnsafeMsgServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -130,6 +164,42 @@ func _MsgService_ProcessPayloadEnvelope_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_ScheduleHardfork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleHardfork)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).ScheduleHardfork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_ScheduleHardfork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ScheduleHardfork(ctx, req.(*MsgScheduleHardfork))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MsgService_ServiceDesc is the grpc.ServiceDesc for MsgService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -145,6 +215,14 @@ var MsgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessPayloadEnvelope",
 			Handler:    _MsgService_ProcessPayloadEnvelope_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _MsgService_UpdateParams_Handler,
+		},
+		{
+			MethodName: "ScheduleHardfork",
+			Handler:    _MsgService_ScheduleHardfork_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "polaris/evm/v1alpha1/tx.proto",
@@ -26,12 +26,18 @@ package config
 import (
 	"fmt"
 	"math/big"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+
+	pruningtypes "cosmossdk.io/store/pruning/types"
 
 	"github.com/berachain/polaris/cosmos/config/flags"
 	"github.com/berachain/polaris/eth"
 	"github.com/berachain/polaris/eth/accounts"
 
 	sdkflags "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	version "github.com/cosmos/cosmos-sdk/version"
@@ -65,9 +71,30 @@ func MustReadConfigFromAppOpts(opts servertypes.AppOptions) *Config {
 }
 
 // ReadConfigFromAppOpts reads the configuration options from the given
-// application options.
+// application options, and validates them.
 func ReadConfigFromAppOpts(opts servertypes.AppOptions) (*Config, error) {
-	return readConfigFromAppOptsParser(AppOptionsParser{AppOptions: opts})
+	cfg, err := readConfigFromAppOptsParser(AppOptionsParser{AppOptions: opts})
+	if err != nil {
+		return nil, err
+	}
+	if err = Validate(cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// ReadConfigFromAppTOML reads the configuration options from the app.toml in the config
+// directory of the given home directory, and validates them. Unlike ReadConfigFromAppOpts, it
+// ignores command line flags and environment variables, which is what the node sees when its
+// config is reloaded.
+func ReadConfigFromAppTOML(home string) (*Config, error) {
+	v := viper.New()
+	v.SetConfigFile(filepath.Join(home, "config", "app.toml"))
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+	v.Set(sdkflags.FlagHome, home)
+	return ReadConfigFromAppOpts(v)
 }
 
 //nolint:funlen,gocognit,gocyclo,cyclop // TODO break up later.
@@ -83,6 +110,54 @@ func readConfigFromAppOptsParser(parser AppOptionsParser) (*Config, error) {
 		return nil, err
 	}
 
+	// Archive mode.
+	if conf.ArchiveMode, err = parser.GetBool(flags.ArchiveMode); err != nil {
+		return nil, err
+	}
+	if conf.ArchiveMode {
+		var pruning string
+		if pruning, err = parser.GetString(server.FlagPruning); err != nil {
+			return nil, err
+		}
+		if pruning != pruningtypes.PruningOptionNothing {
+			return nil, fmt.Errorf(
+				"%s requires %s = %q, got %q", flags.ArchiveMode, server.FlagPruning,
+				pruningtypes.PruningOptionNothing, pruning,
+			)
+		}
+	}
+
+	// Geth log levels.
+	if conf.GethLogLevel, err = parser.GetString(flags.GethLogLevel); err != nil {
+		return nil, err
+	}
+
+	// Tx policies.
+	if conf.TxPolicies.DenyList, err =
+		parser.GetCommonAddressList(flags.TxPolicyDenyList); err != nil {
+		return nil, err
+	}
+	if conf.TxPolicies.MaxCalldataSize, err =
+		parser.GetInt(flags.TxPolicyMaxCalldataSize); err != nil {
+		return nil, err
+	}
+	if conf.TxPolicies.RestrictContractCreation, err =
+		parser.GetBool(flags.TxPolicyRestrictContractCreation); err != nil {
+		return nil, err
+	}
+	if conf.TxPolicies.Deployers, err =
+		parser.GetCommonAddressList(flags.TxPolicyDeployers); err != nil {
+		return nil, err
+	}
+	if conf.TxPolicies.SenderRateLimit, err =
+		parser.GetInt(flags.TxPolicySenderRateLimit); err != nil {
+		return nil, err
+	}
+	if conf.TxPolicies.SenderRateLimitWindow, err =
+		parser.GetTimeDuration(flags.TxPolicySenderRateLimitWindow); err != nil {
+		return nil, err
+	}
+
 	// Polaris Core settings
 	if conf.Polar.RPCGasCap, err =
 		parser.GetUint64(flags.RPCGasCap); err != nil {
@@ -96,6 +171,18 @@ func readConfigFromAppOptsParser(parser AppOptionsParser) (*Config, error) {
 		parser.GetFloat64(flags.RPCTxFeeCap); err != nil {
 		return nil, err
 	}
+	if conf.Polar.RPCReceiptCosmosEvents, err =
+		parser.GetBool(flags.RPCReceiptCosmosEvents); err != nil {
+		return nil, err
+	}
+	if conf.Polar.BlobSidecarRetention, err =
+		parser.GetUint64(flags.BlobSidecarRetention); err != nil {
+		return nil, err
+	}
+	if conf.Polar.BadBlocksDatadir, err =
+		parser.GetString(flags.BadBlocksDatadir); err != nil {
+		return nil, err
+	}
 
 	// Polar Miner settings
 	if conf.Polar.Miner.Etherbase, err =
@@ -110,7 +197,10 @@ func readConfigFromAppOptsParser(parser AppOptionsParser) (*Config, error) {
 	if len(conf.Polar.Miner.ExtraData) == 0 {
 		commit := version.NewInfo().GitCommit
 		if len(commit) != 40 { //nolint:gomnd // its okay.
-			return nil, fmt.Errorf("invalid git commit length: %d", len(commit))
+			return nil, &FieldError{Field: flags.MinerExtraData, Err: fmt.Errorf(
+				"not set, and cannot default to the git commit %q of the binary, which is not "+
+					"40 characters; set it explicitly", commit,
+			)}
 		}
 		conf.Polar.Miner.ExtraData = hexutil.Bytes(
 			commit[32:40],
@@ -336,6 +426,64 @@ func readConfigFromAppOptsParser(parser AppOptionsParser) (*Config, error) {
 		return nil, err
 	}
 
+	// BlobPool
+	if conf.Polar.BlobPool.Datadir, err =
+		parser.GetString(flags.BlobPoolDatadir); err != nil {
+		return nil, err
+	}
+
+	if conf.Polar.BlobPool.Datadir == "" {
+		conf.Polar.BlobPool.Datadir, err =
+			parser.GetString(sdkflags.FlagHome)
+		if err != nil {
+			return nil, err
+		}
+		conf.Polar.BlobPool.Datadir += "/data/blobpool"
+	}
+
+	if conf.Polar.BlobPool.Datacap, err =
+		parser.GetUint64(flags.BlobPoolDatacap); err != nil {
+		return nil, err
+	}
+
+	if conf.Polar.BlobPool.PriceBump, err =
+		parser.GetUint64(flags.BlobPoolPriceBump); err != nil {
+		return nil, err
+	}
+
+	// HistoricalDB
+	if conf.Polar.HistoricalDB.Backend, err =
+		parser.GetString(flags.HistoricalDBBackend); err != nil {
+		return nil, err
+	}
+
+	if conf.Polar.HistoricalDB.Datadir, err =
+		parser.GetString(flags.HistoricalDBDatadir); err != nil {
+		return nil, err
+	}
+
+	// The historical database defaults to the node's data directory. Without a node home it is
+	// left unset rather than created at the filesystem root, which fails to open the database.
+	if conf.Polar.HistoricalDB.Datadir == "" {
+		var home string
+		if home, err = parser.GetString(sdkflags.FlagHome); err != nil {
+			return nil, err
+		}
+		if home != "" {
+			conf.Polar.HistoricalDB.Datadir = home + "/data/historical"
+		}
+	}
+
+	if conf.Polar.HistoricalDB.RetainBlocks, err =
+		parser.GetUint64(flags.HistoricalDBRetainBlocks); err != nil {
+		return nil, err
+	}
+
+	if conf.Polar.HistoricalDB.RetainAge, err =
+		parser.GetTimeDuration(flags.HistoricalDBRetainAge); err != nil {
+		return nil, err
+	}
+
 	// Node settings
 	if conf.Node.Name, err =
 		parser.GetString(flags.Name); err != nil {
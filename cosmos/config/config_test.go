@@ -24,11 +24,16 @@
 package config_test
 
 import (
+	"bytes"
+	"text/template"
+
 	sgconfig "github.com/berachain/polaris/cosmos/config"
 	"github.com/berachain/polaris/eth/accounts"
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/viper"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -55,4 +60,35 @@ var _ = Describe("Configuration", func() {
 		Expect(hdPath).To(Equal("m/44'/60'/0'/0/0"))
 		Expect(hdPath).To(Equal(accounts.BIP44HDPath))
 	})
+
+	Describe("archive mode", func() {
+		var v *viper.Viper
+
+		BeforeEach(func() {
+			cfg := sgconfig.DefaultPolarisConfig()
+			cfg.ArchiveMode = true
+			cfg.Polar.Miner.ExtraData = []byte("polaris")
+			var buf bytes.Buffer
+			Expect(template.Must(template.New("app").Parse(sgconfig.PolarisConfigTemplate)).
+				Execute(&buf, struct{ Polaris *sgconfig.Config }{cfg})).To(Succeed())
+			v = viper.New()
+			v.SetConfigType("toml")
+			Expect(v.ReadConfig(&buf)).To(Succeed())
+		})
+
+		It("should require that no version of any store is pruned", func() {
+			for _, pruning := range []string{"default", "everything", "custom"} {
+				v.Set(server.FlagPruning, pruning)
+				_, err := sgconfig.ReadConfigFromAppOpts(v)
+				Expect(err).To(MatchError(ContainSubstring(`requires pruning = "nothing"`)))
+			}
+		})
+
+		It("should read the config with pruning nothing", func() {
+			v.Set(server.FlagPruning, "nothing")
+			cfg, err := sgconfig.ReadConfigFromAppOpts(v)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cfg.ArchiveMode).To(BeTrue())
+		})
+	})
 })
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package config
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+)
+
+var (
+	// ignoredFields are the fields that are not read from app.toml, so they always differ from
+	// their defaults.
+	ignoredFields = map[string]struct{}{"Node.P2P": {}}
+
+	bigIntType   = reflect.TypeOf(big.Int{})
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// FieldDiff is a configuration value that differs from its default.
+type FieldDiff struct {
+	// Field is the path of the field in the config, e.g. "Polar.Miner.GasFloor".
+	Field string
+	// Value is the configured value.
+	Value string
+	// Default is the default value.
+	Default string
+}
+
+// DiffFromDefaults returns every value of the given config that differs from
+// DefaultPolarisConfig, in field order.
+func DiffFromDefaults(cfg *Config) []FieldDiff {
+	return Diff(cfg, DefaultPolarisConfig())
+}
+
+// Diff returns every value of the given config that differs from the base config, in field
+// order. The Default of each difference is the value in the base config.
+func Diff(cfg, base *Config) []FieldDiff {
+	var diffs []FieldDiff
+	diffValues("", reflect.ValueOf(cfg).Elem(), reflect.ValueOf(base).Elem(), &diffs)
+	return diffs
+}
+
+// diffValues appends the differences between the given values to diffs. Config structs are
+// walked field by field, while funcs, channels and interfaces, which cannot be set from the
+// app config, are ignored.
+func diffValues(path string, value, def reflect.Value, diffs *[]FieldDiff) {
+	switch value.Kind() { //nolint:exhaustive // only the kinds that need special handling.
+	case reflect.Func, reflect.Chan, reflect.Interface, reflect.UnsafePointer:
+		return
+	case reflect.Struct:
+		if !value.Type().Implements(stringerType) && value.Type() != bigIntType {
+			for i := 0; i < value.NumField(); i++ {
+				field := value.Type().Field(i)
+				if !field.IsExported() {
+					continue
+				}
+				fieldPath := path
+				if !field.Anonymous {
+					fieldPath = joinPath(path, field.Name)
+				}
+				if _, ignored := ignoredFields[fieldPath]; ignored {
+					continue
+				}
+				diffValues(fieldPath, value.Field(i), def.Field(i), diffs)
+			}
+			return
+		}
+	case reflect.Pointer:
+		// Only pointers to plain values are compared, so that nested objects such as keys are
+		// never printed.
+		elem := value.Type().Elem()
+		if elem.Kind() == reflect.Struct && elem != bigIntType {
+			return
+		}
+		if value.IsNil() || def.IsNil() {
+			if value.IsNil() != def.IsNil() {
+				*diffs = append(*diffs, FieldDiff{path, formatValue(value), formatValue(def)})
+			}
+			return
+		}
+		diffValues(path, value.Elem(), def.Elem(), diffs)
+		return
+	case reflect.Slice, reflect.Map:
+		if value.Len() == 0 && def.Len() == 0 {
+			return
+		}
+	}
+
+	if !equalValues(value, def) {
+		*diffs = append(*diffs, FieldDiff{path, formatValue(value), formatValue(def)})
+	}
+}
+
+// equalValues reports whether the given values are equal.
+func equalValues(value, def reflect.Value) bool {
+	if value.Type() == bigIntType {
+		a, b := value.Addr().Interface().(*big.Int), def.Addr().Interface().(*big.Int)
+		return a.Cmp(b) == 0
+	}
+	return reflect.DeepEqual(value.Interface(), def.Interface())
+}
+
+// formatValue returns the printable form of the given value.
+func formatValue(value reflect.Value) string {
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return "<nil>"
+		}
+		value = value.Elem()
+	}
+	if value.Type() == bigIntType {
+		return value.Addr().Interface().(*big.Int).String()
+	}
+	return fmt.Sprint(value.Interface())
+}
+
+// joinPath joins a field name to the given path.
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
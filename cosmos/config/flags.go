@@ -34,4 +34,6 @@ import (
 func AddPolarisFlags(startCmd *cobra.Command) {
 	_ = polar.DefaultConfig()
 	startCmd.Flags().Bool(flags.OptimisticExecution, false, "Enable optimistic execution")
+	startCmd.Flags().Bool(flags.ArchiveMode, false, "Serve EVM state at every historical height")
+	startCmd.Flags().String(flags.GethLogLevel, "", "Minimum level of geth logs per subsystem")
 }
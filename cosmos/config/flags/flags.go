@@ -25,12 +25,30 @@ package flags
 
 const (
 	OptimisticExecution = "polaris.optimistic-execution"
+	ArchiveMode         = "polaris.archive-mode"
+	GethLogLevel        = "polaris.geth-log-level"
+
+	// Tx Policies.
+	TxPolicyDenyList                 = "polaris.tx-policies.deny-list"
+	TxPolicyMaxCalldataSize          = "polaris.tx-policies.max-calldata-size"
+	TxPolicyRestrictContractCreation = "polaris.tx-policies.restrict-contract-creation"
+	TxPolicyDeployers                = "polaris.tx-policies.deployers"
+	TxPolicySenderRateLimit          = "polaris.tx-policies.sender-rate-limit"
+	TxPolicySenderRateLimitWindow    = "polaris.tx-policies.sender-rate-limit-window"
 
 	// Polar Root.
 	RPCEvmTimeout = "polaris.polar.rpc-evm-timeout"
 	RPCTxFeeCap   = "polaris.polar.rpc-tx-fee-cap"
 	RPCGasCap     = "polaris.polar.rpc-gas-cap"
 
+	RPCReceiptCosmosEvents = "polaris.polar.rpc-receipt-cosmos-events"
+
+	// Blob Sidecars.
+	BlobSidecarRetention = "polaris.polar.blob-sidecar-retention"
+
+	// Bad Blocks.
+	BadBlocksDatadir = "polaris.polar.bad-blocks-datadir"
+
 	// Miner.
 	MinerEtherbase         = "polaris.polar.miner.etherbase"
 	MinerExtraData         = "polaris.polar.miner.extra-data"
@@ -45,6 +63,9 @@ const (
 	MaxBlockHistory  = "polaris.polar.gpo.max-block-history"
 	Percentile       = "polaris.polar.gpo.percentile"
 	MaxHeaderHistory = "polaris.polar.gpo.max-header-history"
+	Default          = "polaris.polar.gpo.default"
+	MaxPrice         = "polaris.polar.gpo.max-price"
+	IgnorePrice      = "polaris.polar.gpo.ignore-price"
 
 	// Node.
 	JwtSecret             = "polaris.node.jwt-secret" //#nosec: G101 // not a secret.
@@ -82,9 +103,6 @@ const (
 	ReadHeaderTimeout     = "polaris.node.http-timeouts.read-header-timeout"
 	HTTPModules           = "polaris.node.http-modules"
 	WsOrigins             = "polaris.node.ws-origins"
-	Default               = "polaris.node.http-timeouts.default"
-	MaxPrice              = "polaris.node.http-timeouts.max-price"
-	IgnorePrice           = "polaris.node.http-timeouts.ignore-price"
 	GraphqlVirtualHosts   = "polaris.node.graphql-virtual-hosts"
 	IpcPath               = "polaris.node.ipc-path"
 
@@ -101,6 +119,17 @@ const (
 	GlobalQueue  = "polaris.polar.legacy-tx-pool.global-queue"
 	Lifetime     = "polaris.polar.legacy-tx-pool.lifetime"
 
+	// Blob TxPool.
+	BlobPoolDatadir   = "polaris.polar.blob-tx-pool.datadir"
+	BlobPoolDatacap   = "polaris.polar.blob-tx-pool.datacap"
+	BlobPoolPriceBump = "polaris.polar.blob-tx-pool.price-bump"
+
+	// Historical DB.
+	HistoricalDBBackend      = "polaris.polar.historical-db.backend"
+	HistoricalDBDatadir      = "polaris.polar.historical-db.datadir"
+	HistoricalDBRetainBlocks = "polaris.polar.historical-db.retain-blocks"
+	HistoricalDBRetainAge    = "polaris.polar.historical-db.retain-age"
+
 	// Chain Config.
 	ChainID                       = "polaris.polar.chain.chain-id"
 	HomesteadBlock                = "polaris.polar.chain.homestead-block"
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/slog"
+
+	ethlog "github.com/ethereum/go-ethereum/log"
+)
+
+// defaultGethSubsystem is the key of the level of the geth subsystems without a level of their
+// own.
+const defaultGethSubsystem = "*"
+
+// gethLevels are the levels that geth logs can be filtered at, from the most verbose.
+var gethLevels = []slog.Level{
+	ethlog.LevelTrace, ethlog.LevelDebug, ethlog.LevelInfo, ethlog.LevelWarn, ethlog.LevelError,
+	ethlog.LevelCrit,
+}
+
+// GethLogLevels are the minimum levels of geth logs, keyed by geth subsystem, e.g. "txpool" or
+// "miner", with the level of every other subsystem under "*".
+type GethLogLevels map[string]slog.Level
+
+// ParseGethLogLevels parses geth log levels in the format of the Cosmos log_level, i.e. a comma
+// separated list of subsystem:level pairs, e.g. "txpool:debug,miner:warn,*:info". A level
+// without a subsystem applies to every other subsystem. Empty emits every geth log, leaving the
+// filtering to the Cosmos logger.
+func ParseGethLogLevels(s string) (GethLogLevels, error) {
+	levels := make(GethLogLevels)
+	if strings.TrimSpace(s) == "" {
+		return levels, nil
+	}
+	for _, pair := range strings.Split(s, ",") {
+		subsystem, name, found := strings.Cut(strings.TrimSpace(pair), ":")
+		if !found {
+			subsystem, name = defaultGethSubsystem, subsystem
+		}
+		if subsystem == "" {
+			return nil, fmt.Errorf("missing subsystem in %q", pair)
+		}
+		if _, ok := levels[subsystem]; ok {
+			return nil, fmt.Errorf("duplicate level for subsystem %q", subsystem)
+		}
+		level, err := parseGethLogLevel(name)
+		if err != nil {
+			return nil, err
+		}
+		levels[subsystem] = level
+	}
+	return levels, nil
+}
+
+// parseGethLogLevel returns the geth log level with the given name, e.g. "debug".
+func parseGethLogLevel(name string) (slog.Level, error) {
+	for _, level := range gethLevels {
+		if ethlog.LevelString(level) == name {
+			return level, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown geth log level %q", name)
+}
+
+// Level returns the minimum level of the logs of the given geth subsystem.
+func (l GethLogLevels) Level(subsystem string) slog.Level {
+	if level, ok := l[subsystem]; ok {
+		return level
+	}
+	if level, ok := l[defaultGethSubsystem]; ok {
+		return level
+	}
+	return ethlog.LevelTrace
+}
+
+// MinLevel returns the lowest level that the logs of any geth subsystem are emitted at.
+func (l GethLogLevels) MinLevel() slog.Level {
+	minLevel := l.Level(defaultGethSubsystem)
+	for _, level := range l {
+		if level < minLevel {
+			minLevel = level
+		}
+	}
+	return minLevel
+}
+
+// String returns the levels in the format parsed by ParseGethLogLevels.
+func (l GethLogLevels) String() string {
+	pairs := make([]string, 0, len(l))
+	for subsystem, level := range l {
+		pairs = append(pairs, subsystem+":"+ethlog.LevelString(level))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
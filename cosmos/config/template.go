@@ -29,10 +29,44 @@ const (
 ###############################################################################
 ###                                 Polaris                                 ###
 ###############################################################################
-# General Polaris settings
+# General Polaris settings. On SIGHUP, the node reloads geth-log-level, rpc-gas-cap,
+# rpc-evm-timeout, rpc-tx-fee-cap, and the price-limit, lifetime and account-slots of the
+# legacy-tx-pool. Any other change, e.g. to global-slots, is logged and requires a restart.
 [polaris]
 optimistic-execution = {{ .Polaris.OptimisticExecution }}
 
+# Archive mode serves eth_call, eth_getBalance, eth_getStorageAt and debug_traceTransaction at
+# any historical height. EVM state is read from the versioned evm and auth stores, which cannot
+# be retained apart from the other stores, so archive mode only asserts pruning = "nothing" and
+# the node keeps every version of every store. Without it, requests for heights before the
+# earliest version retained by the evm store fail with a "historical state pruned" error.
+archive-mode = {{ .Polaris.ArchiveMode }}
+
+# Minimum level of the geth logs per geth subsystem, as comma separated subsystem:level pairs
+# with the levels trace, debug, info, warn, error and crit, e.g. "txpool:debug,miner:warn,*:info".
+# Geth logs carry the module polaris-geth/<subsystem>, so they are also filtered by log_level in
+# config.toml. Empty leaves the filtering to log_level.
+geth-log-level = "{{ .Polaris.GethLogLevel }}"
+
+# Admission policies that every transaction must satisfy to enter, and to remain in, the mempool.
+# Each policy is disabled when its settings are zero.
+[polaris.tx-policies]
+# Addresses that may not send or receive transactions
+deny-list = [{{ range $i, $addr := .Polaris.TxPolicies.DenyList }}{{ if $i }}, {{ end }}"{{ $addr.Hex }}"{{ end }}]
+
+# Maximum size of the calldata of a transaction in bytes
+max-calldata-size = {{ .Polaris.TxPolicies.MaxCalldataSize }}
+
+# Only allow the deployers to create contracts
+restrict-contract-creation = {{ .Polaris.TxPolicies.RestrictContractCreation }}
+
+# Addresses allowed to create contracts when contract creation is restricted
+deployers = [{{ range $i, $addr := .Polaris.TxPolicies.Deployers }}{{ if $i }}, {{ end }}"{{ $addr.Hex }}"{{ end }}]
+
+# Maximum number of transactions of a sender that the mempool accepts per sender-rate-limit-window
+sender-rate-limit = {{ .Polaris.TxPolicies.SenderRateLimit }}
+sender-rate-limit-window = "{{ .Polaris.TxPolicies.SenderRateLimitWindow }}"
+
 [polaris.polar]
 # Gas cap for RPC requests
 rpc-gas-cap = "{{ .Polaris.Polar.RPCGasCap }}"
@@ -43,6 +77,16 @@ rpc-evm-timeout = "{{ .Polaris.Polar.RPCEVMTimeout }}"
 # Transaction fee cap for RPC requests
 rpc-tx-fee-cap = "{{ .Polaris.Polar.RPCTxFeeCap }}"
 
+# Include the Cosmos events emitted by precompiles in eth_getTransactionReceipt responses
+rpc-receipt-cosmos-events = {{ .Polaris.Polar.RPCReceiptCosmosEvents }}
+
+# Number of blocks for which blob sidecars are retained
+blob-sidecar-retention = "{{ .Polaris.Polar.BlobSidecarRetention }}"
+
+# Directory of the database that rejected blocks are stored in for debug_traceBadBlock and
+# debug_getBadBlocks, rejected blocks are only kept in memory if empty
+bad-blocks-datadir = "{{ .Polaris.Polar.BadBlocksDatadir }}"
+
 # Chain config
 [polaris.polar.chain] 
 chain-id = "{{ .Polaris.Polar.Chain.ChainID }}"
@@ -161,7 +205,8 @@ max-price = "{{ .Polaris.Polar.GPO.MaxPrice }}"
 # Prices to ignore for gas price determination
 ignore-price = "{{ .Polaris.Polar.GPO.IgnorePrice }}"
 
-# LegacyTxPool settings
+# LegacyTxPool settings. Only price-limit, lifetime and account-slots are reloaded on SIGHUP;
+# the other slot and queue settings, e.g. global-slots, require a restart.
 [polaris.polar.legacy-tx-pool]
 
 # Addresses that should be treated by default as local
@@ -176,7 +221,7 @@ journal = "{{ .Polaris.Polar.LegacyTxPool.Journal }}"
 #  Time interval to regenerate the local transaction journal
 rejournal = "{{ .Polaris.Polar.LegacyTxPool.Rejournal }}"
 
-# Minimum gas price to enforce for acceptance into the pool
+# Minimum gas price to enforce for acceptance into the pool, at most the miner gas-price
 price-limit = "{{ .Polaris.Polar.LegacyTxPool.PriceLimit }}"
 
 # Minimum price bump percentage to replace an already existing transaction (nonce)
@@ -197,6 +242,35 @@ global-queue = "{{ .Polaris.Polar.LegacyTxPool.GlobalQueue }}"
 # Maximum amount of time non-executable transaction are queued
 lifetime = "{{ .Polaris.Polar.LegacyTxPool.Lifetime }}"
 
+# BlobTxPool settings
+[polaris.polar.blob-tx-pool]
+
+# Data directory containing the currently executable blobs
+datadir = "{{ .Polaris.Polar.BlobPool.Datadir }}"
+
+# Soft-cap of database storage (hard cap is larger due to overhead)
+datacap = "{{ .Polaris.Polar.BlobPool.Datacap }}"
+
+# Minimum price bump percentage to replace an already existing blob transaction (nonce)
+price-bump = "{{ .Polaris.Polar.BlobPool.PriceBump }}"
+
+# HistoricalDB settings
+[polaris.polar.historical-db]
+
+# Backend of historical blocks, receipts and tx lookups, which are node-local: "leveldb",
+# "pebble" (requires a build with the pebbledb tag) or "memory" (lost on restart). Data stored in
+# the consensus store by older versions is copied with migrate-historical-db
+backend = "{{ .Polaris.Polar.HistoricalDB.Backend }}"
+
+# Data directory of the historical database, which defaults to data/historical in the node home
+datadir = "{{ .Polaris.Polar.HistoricalDB.Datadir }}"
+
+# Number of most recent blocks whose historical data is retained, 0 retains every block
+retain-blocks = "{{ .Polaris.Polar.HistoricalDB.RetainBlocks }}"
+
+# How long the historical data of a block is retained, 0 retains blocks regardless of their age
+retain-age = "{{ .Polaris.Polar.HistoricalDB.RetainAge }}"
+
 
 # Node-specific settings
 [polaris.node]
@@ -0,0 +1,232 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/berachain/polaris/cosmos/config/flags"
+
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// maxPort is the highest valid TCP port.
+const maxPort = 65535
+
+// validHistoricalDBBackends are the valid historical database backends, where empty is "leveldb".
+var validHistoricalDBBackends = map[string]bool{
+	"": true, "leveldb": true, "pebble": true, "memory": true,
+}
+
+// validDBEngines are the valid node database engines, where empty is the default.
+var validDBEngines = map[string]bool{"": true, "leveldb": true, "pebble": true}
+
+// FieldError is an invalid value of a config field, identified by its app.toml key.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+// Error implements error.
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Field, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// fieldErrors collects the field errors of a config.
+type fieldErrors []error
+
+// add adds a field error for the given field, if the condition holds.
+func (errs *fieldErrors) add(invalid bool, field, format string, args ...any) {
+	if invalid {
+		*errs = append(*errs, &FieldError{Field: field, Err: fmt.Errorf(format, args...)})
+	}
+}
+
+// Validate checks the node, miner, txpool, tx policies, GPO and chain sections of the given
+// config for invalid values and combinations of values, which are otherwise silently sanitized or
+// fail with opaque errors at startup. It returns every problem found, as FieldErrors joined together.
+func Validate(cfg *Config) error {
+	var errs fieldErrors
+	validateNode(cfg, &errs)
+	validateMiner(cfg, &errs)
+	validateTxPool(cfg, &errs)
+	validateGPO(cfg, &errs)
+	validateChain(cfg, &errs)
+
+	validateTxPolicies(cfg, &errs)
+	_, err := ParseGethLogLevels(cfg.GethLogLevel)
+	errs.add(err != nil, flags.GethLogLevel, "%v", err)
+	errs.add(cfg.Polar.RPCTxFeeCap < 0, flags.RPCTxFeeCap, "must not be negative, got %v",
+		cfg.Polar.RPCTxFeeCap)
+	errs.add(cfg.Polar.RPCEVMTimeout < 0, flags.RPCEvmTimeout, "must not be negative, got %s",
+		cfg.Polar.RPCEVMTimeout)
+	errs.add(!validHistoricalDBBackends[cfg.Polar.HistoricalDB.Backend], flags.HistoricalDBBackend,
+		"must be \"leveldb\", \"pebble\" or \"memory\", got %q; historical data is no longer "+
+			"stored in the consensus store",
+		cfg.Polar.HistoricalDB.Backend)
+	errs.add(cfg.Polar.HistoricalDB.RetainAge < 0, flags.HistoricalDBRetainAge,
+		"must not be negative, got %s", cfg.Polar.HistoricalDB.RetainAge)
+	return errors.Join(errs...)
+}
+
+// validateNode validates the node section of the given config.
+func validateNode(cfg *Config, errs *fieldErrors) {
+	node := &cfg.Node
+	for _, port := range []struct {
+		field string
+		value int
+	}{
+		{flags.HTTPPort, node.HTTPPort}, {flags.WsPort, node.WSPort}, {flags.AuthPort, node.AuthPort},
+	} {
+		errs.add(port.value < 0 || port.value > maxPort, port.field,
+			"must be between 0 and %d, got %d", maxPort, port.value)
+	}
+	errs.add(node.HTTPHost != "" && node.AuthAddr == node.HTTPHost &&
+		node.AuthPort == node.HTTPPort, flags.AuthPort,
+		"must differ from %s %d, as authenticated APIs are served separately", flags.HTTPPort,
+		node.HTTPPort)
+	errs.add(node.BatchRequestLimit < 0, flags.BatchRequestLimit, "must not be negative, got %d",
+		node.BatchRequestLimit)
+	errs.add(node.BatchResponseMaxSize < 0, flags.BatchResponseMaxSize,
+		"must not be negative, got %d", node.BatchResponseMaxSize)
+	errs.add(!validDBEngines[node.DBEngine], flags.DBEngine,
+		"must be \"leveldb\" or \"pebble\", got %q", node.DBEngine)
+	for _, timeout := range []struct {
+		field string
+		value time.Duration
+	}{
+		{flags.ReadTimeout, node.HTTPTimeouts.ReadTimeout},
+		{flags.ReadHeaderTimeout, node.HTTPTimeouts.ReadHeaderTimeout},
+		{flags.WriteTimeout, node.HTTPTimeouts.WriteTimeout},
+		{flags.IdleTimeout, node.HTTPTimeouts.IdleTimeout},
+	} {
+		errs.add(timeout.value < 0, timeout.field, "must not be negative, got %s", timeout.value)
+	}
+}
+
+// validateMiner validates the miner section of the given config.
+func validateMiner(cfg *Config, errs *fieldErrors) {
+	miner := &cfg.Polar.Miner
+	errs.add(miner.GasCeil < params.MinGasLimit || miner.GasCeil > params.MaxGasLimit,
+		flags.MinerGasCeil, "must be between %d and %d, got %d", params.MinGasLimit,
+		params.MaxGasLimit, miner.GasCeil)
+	errs.add(miner.GasFloor > miner.GasCeil, flags.MinerGasFloor,
+		"must not exceed %s %d, got %d", flags.MinerGasCeil, miner.GasCeil, miner.GasFloor)
+	errs.add(miner.GasPrice == nil || miner.GasPrice.Sign() <= 0, flags.MinerGasPrice,
+		"must be positive, got %v", miner.GasPrice)
+	errs.add(uint64(len(miner.ExtraData)) > params.MaximumExtraDataSize, flags.MinerExtraData,
+		"must be at most %d bytes, got %d", params.MaximumExtraDataSize, len(miner.ExtraData))
+	errs.add(miner.Recommit < 0, flags.MinerRecommit, "must not be negative, got %s",
+		miner.Recommit)
+	errs.add(miner.NewPayloadTimeout < 0, flags.MinerNewPayloadTimeout,
+		"must not be negative, got %s", miner.NewPayloadTimeout)
+}
+
+// validateTxPool validates the legacy and blob txpool sections of the given config.
+func validateTxPool(cfg *Config, errs *fieldErrors) {
+	pool := &cfg.Polar.LegacyTxPool
+	errs.add(pool.PriceLimit < 1, flags.PriceLimit, "must be at least 1, got %d",
+		pool.PriceLimit)
+	// A pool with a price limit above the miner's gas price turns away transactions that the
+	// miner would include in a block.
+	if gasPrice := cfg.Polar.Miner.GasPrice; gasPrice != nil {
+		errs.add(new(big.Int).SetUint64(pool.PriceLimit).Cmp(gasPrice) > 0, flags.PriceLimit,
+			"must not exceed %s %s, got %d", flags.MinerGasPrice, gasPrice, pool.PriceLimit)
+	}
+	errs.add(pool.PriceBump < 1, flags.PriceBump, "must be at least 1, got %d", pool.PriceBump)
+	for _, limit := range []struct {
+		field string
+		value uint64
+	}{
+		{flags.AccountSlots, pool.AccountSlots}, {flags.GlobalSlots, pool.GlobalSlots},
+		{flags.AccountQueue, pool.AccountQueue}, {flags.GlobalQueue, pool.GlobalQueue},
+	} {
+		errs.add(limit.value < 1, limit.field, "must be at least 1, got %d", limit.value)
+	}
+	errs.add(pool.AccountSlots > pool.GlobalSlots, flags.AccountSlots,
+		"must not exceed %s %d, got %d", flags.GlobalSlots, pool.GlobalSlots, pool.AccountSlots)
+	errs.add(pool.AccountQueue > pool.GlobalQueue, flags.AccountQueue,
+		"must not exceed %s %d, got %d", flags.GlobalQueue, pool.GlobalQueue, pool.AccountQueue)
+	errs.add(pool.Lifetime <= 0, flags.Lifetime, "must be positive, got %s", pool.Lifetime)
+	errs.add(pool.Rejournal < time.Second, flags.ReJournal, "must be at least 1s, got %s",
+		pool.Rejournal)
+
+	blobPool := &cfg.Polar.BlobPool
+	errs.add(blobPool.Datacap < 1, flags.BlobPoolDatacap, "must be at least 1, got %d",
+		blobPool.Datacap)
+	errs.add(blobPool.PriceBump < 1, flags.BlobPoolPriceBump, "must be at least 1, got %d",
+		blobPool.PriceBump)
+}
+
+// validateTxPolicies validates the tx policies section of the given config.
+func validateTxPolicies(cfg *Config, errs *fieldErrors) {
+	policies := &cfg.TxPolicies
+	errs.add(policies.MaxCalldataSize < 0, flags.TxPolicyMaxCalldataSize,
+		"must not be negative, got %d", policies.MaxCalldataSize)
+	errs.add(policies.SenderRateLimit < 0, flags.TxPolicySenderRateLimit,
+		"must not be negative, got %d", policies.SenderRateLimit)
+	errs.add(policies.SenderRateLimit > 0 && policies.SenderRateLimitWindow <= 0,
+		flags.TxPolicySenderRateLimitWindow, "must be positive when %s is set, got %s",
+		flags.TxPolicySenderRateLimit, policies.SenderRateLimitWindow)
+}
+
+// validateGPO validates the gas price oracle section of the given config.
+func validateGPO(cfg *Config, errs *fieldErrors) {
+	gpo := &cfg.Polar.GPO
+	errs.add(gpo.Blocks < 1, flags.Blocks, "must be at least 1, got %d", gpo.Blocks)
+	errs.add(gpo.Percentile < 0 || gpo.Percentile > 100, flags.Percentile,
+		"must be between 0 and 100, got %d", gpo.Percentile)
+	errs.add(gpo.MaxHeaderHistory < 1, flags.MaxHeaderHistory, "must be at least 1, got %d",
+		gpo.MaxHeaderHistory)
+	errs.add(gpo.MaxBlockHistory < 1, flags.MaxBlockHistory, "must be at least 1, got %d",
+		gpo.MaxBlockHistory)
+	errs.add(gpo.MaxPrice == nil || gpo.MaxPrice.Sign() <= 0, flags.MaxPrice,
+		"must be positive, got %v", gpo.MaxPrice)
+	errs.add(gpo.IgnorePrice == nil || gpo.IgnorePrice.Sign() <= 0, flags.IgnorePrice,
+		"must be positive, got %v", gpo.IgnorePrice)
+	errs.add(gpo.Default == nil || gpo.Default.Sign() < 0, flags.Default,
+		"must not be negative, got %v", gpo.Default)
+	if gpo.Default != nil && gpo.MaxPrice != nil {
+		errs.add(gpo.Default.Cmp(gpo.MaxPrice) > 0, flags.Default, "must not exceed %s %s, got %s",
+			flags.MaxPrice, gpo.MaxPrice, gpo.Default)
+	}
+}
+
+// validateChain validates the chain section of the given config.
+func validateChain(cfg *Config, errs *fieldErrors) {
+	chain := &cfg.Polar.Chain
+	errs.add(chain.ChainID == nil || chain.ChainID.Sign() <= 0, flags.ChainID,
+		"must be positive, got %v", chain.ChainID)
+	if err := chain.CheckConfigForkOrder(); err != nil {
+		*errs = append(*errs, &FieldError{Field: "polaris.polar.chain", Err: err})
+	}
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package config_test
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/berachain/polaris/cosmos/config"
+	"github.com/berachain/polaris/cosmos/config/flags"
+
+	ethlog "github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Validate", func() {
+	var cfg *config.Config
+
+	BeforeEach(func() {
+		cfg = config.DefaultPolarisConfig()
+	})
+
+	// fieldsOf returns the fields of the field errors joined in err.
+	fieldsOf := func(err error) []string {
+		var fields []string
+		for _, e := range err.(interface{ Unwrap() []error }).Unwrap() { //nolint:errorlint // joined.
+			var fieldErr *config.FieldError
+			Expect(errors.As(e, &fieldErr)).To(BeTrue())
+			fields = append(fields, fieldErr.Field)
+		}
+		return fields
+	}
+
+	It("should accept the default config", func() {
+		Expect(config.Validate(cfg)).To(Succeed())
+	})
+
+	It("should report every invalid field", func() {
+		cfg.Polar.Miner.GasFloor = cfg.Polar.Miner.GasCeil + 1
+		cfg.Polar.GPO.Percentile = 101
+		cfg.Polar.LegacyTxPool.AccountSlots = 0
+
+		err := config.Validate(cfg)
+		Expect(err).To(HaveOccurred())
+		Expect(fieldsOf(err)).To(ConsistOf(
+			flags.MinerGasFloor, flags.Percentile, flags.AccountSlots,
+		))
+	})
+
+	It("should reject a price limit above the miner gas price", func() {
+		cfg.Polar.LegacyTxPool.PriceLimit = cfg.Polar.Miner.GasPrice.Uint64() + 1
+
+		Expect(fieldsOf(config.Validate(cfg))).To(ConsistOf(flags.PriceLimit))
+
+		cfg.Polar.LegacyTxPool.PriceLimit = cfg.Polar.Miner.GasPrice.Uint64() - 1
+		Expect(config.Validate(cfg)).To(Succeed())
+	})
+
+	It("should reject forks out of order", func() {
+		cfg.Polar.Chain.LondonBlock = big.NewInt(10)
+		cfg.Polar.Chain.BerlinBlock = big.NewInt(20)
+
+		Expect(config.Validate(cfg)).To(MatchError(ContainSubstring("polaris.polar.chain")))
+	})
+
+	It("should reject an unknown geth log level", func() {
+		cfg.GethLogLevel = "txpool:verbose"
+
+		Expect(fieldsOf(config.Validate(cfg))).To(ConsistOf(flags.GethLogLevel))
+	})
+})
+
+var _ = Describe("ParseGethLogLevels", func() {
+	It("should parse the level of each subsystem", func() {
+		levels, err := config.ParseGethLogLevels("txpool:debug, miner:warn,info")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(levels.Level("txpool")).To(Equal(ethlog.LevelDebug))
+		Expect(levels.Level("miner")).To(Equal(ethlog.LevelWarn))
+		Expect(levels.Level("state")).To(Equal(ethlog.LevelInfo))
+		Expect(levels.MinLevel()).To(Equal(ethlog.LevelDebug))
+		Expect(levels.String()).To(Equal("*:info,miner:warn,txpool:debug"))
+	})
+
+	It("should emit every log when empty", func() {
+		levels, err := config.ParseGethLogLevels("")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(levels.Level("txpool")).To(Equal(ethlog.LevelTrace))
+	})
+
+	It("should reject duplicate subsystems", func() {
+		_, err := config.ParseGethLogLevels("txpool:debug,txpool:info")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("DiffFromDefaults", func() {
+	It("should not report any difference for the default config", func() {
+		Expect(config.DiffFromDefaults(config.DefaultPolarisConfig())).To(BeEmpty())
+	})
+
+	It("should report the changed values", func() {
+		cfg := config.DefaultPolarisConfig()
+		cfg.Polar.Miner.GasFloor = 1
+		cfg.Polar.Chain.ChainID = big.NewInt(2061)
+
+		Expect(config.DiffFromDefaults(cfg)).To(ConsistOf(
+			config.FieldDiff{Field: "Polar.Miner.GasFloor", Value: "1", Default: "0"},
+			config.FieldDiff{
+				Field:   "Polar.Chain.ChainID",
+				Value:   "2061",
+				Default: config.DefaultPolarisConfig().Polar.Chain.ChainID.String(),
+			},
+		))
+	})
+
+	It("should report the values that differ from a base config", func() {
+		base := config.DefaultPolarisConfig()
+		base.Polar.RPCGasCap = 1
+		cfg := config.DefaultPolarisConfig()
+		cfg.Polar.RPCGasCap = 2
+
+		Expect(config.Diff(cfg, base)).To(ConsistOf(
+			config.FieldDiff{Field: "Polar.RPCGasCap", Value: "2", Default: "1"},
+		))
+	})
+})
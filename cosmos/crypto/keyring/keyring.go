@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package keyring
+
+import (
+	signinglib "github.com/berachain/polaris/cosmos/lib/signing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// eip712Keyring is a keyring that signs the EIP-712 sign mode with its Ledger keys.
+type eip712Keyring struct {
+	keyring.Keyring
+}
+
+// NewEIP712Keyring wraps the given keyring to sign the EIP-712 sign mode with its Ledger keys as
+// well. The SDK only signs the textual and Amino JSON sign modes with Ledger keys, while the
+// Ledger Ethereum app only signs EIP-712 sign bytes.
+func NewEIP712Keyring(kr keyring.Keyring) keyring.Keyring {
+	if kr == nil {
+		return nil
+	}
+	if _, ok := kr.(eip712Keyring); ok {
+		return kr
+	}
+	return eip712Keyring{Keyring: kr}
+}
+
+// Sign implements keyring.Keyring.
+func (kr eip712Keyring) Sign(
+	uid string, msg []byte, signMode signing.SignMode,
+) ([]byte, cryptotypes.PubKey, error) {
+	record, err := kr.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	return kr.sign(record, msg, signMode)
+}
+
+// SignByAddress implements keyring.Keyring.
+func (kr eip712Keyring) SignByAddress(
+	address sdk.Address, msg []byte, signMode signing.SignMode,
+) ([]byte, cryptotypes.PubKey, error) {
+	record, err := kr.KeyByAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+	return kr.sign(record, msg, signMode)
+}
+
+// sign signs the given message with the key of the given record.
+func (kr eip712Keyring) sign(
+	record *keyring.Record, msg []byte, signMode signing.SignMode,
+) ([]byte, cryptotypes.PubKey, error) {
+	if signMode != signinglib.TxSignModeEIP712 || record.GetLedger() == nil {
+		return kr.Keyring.Sign(record.Name, msg, signMode)
+	}
+	// The Ethereum app ignores the sign mode, so the sign bytes are signed as textual ones, which
+	// checks the public key of the device against the record and verifies the signature.
+	return keyring.SignWithLedger(record, msg, signing.SignMode_SIGN_MODE_TEXTUAL)
+}
@@ -25,8 +25,11 @@ package keyring
 
 import (
 	"github.com/berachain/polaris/cosmos/crypto/hd"
+	"github.com/berachain/polaris/cosmos/crypto/keys/ethsecp256k1"
+	"github.com/berachain/polaris/cosmos/crypto/ledger"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
 // OnlyEthSecp256k1Option defines a function keys options for the ethereum Secp256k1 curve.
@@ -34,5 +37,11 @@ func OnlyEthSecp256k1Option() keyring.Option {
 	return func(options *keyring.Options) {
 		options.SupportedAlgos = keyring.SigningAlgoList{hd.EthSecp256k1}
 		options.SupportedAlgosLedger = keyring.SigningAlgoList{hd.EthSecp256k1}
+		options.LedgerDerivation = ledger.DiscoverEthSecp256k1
+		options.LedgerCreateKey = func(key []byte) cryptotypes.PubKey {
+			return &ethsecp256k1.PubKey{Key: key}
+		}
+		options.LedgerAppName = ledger.AppName
+		options.LedgerSigSkipDERConv = true
 	}
 }
@@ -0,0 +1,238 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package ledger
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/berachain/polaris/eth/accounts"
+
+	sdkledger "github.com/cosmos/cosmos-sdk/crypto/ledger"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// AppName is the name of the Ledger app used to sign with eth_secp256k1 keys.
+const AppName = "Ethereum"
+
+// APDU commands of the Ledger Ethereum app, see
+// https://github.com/LedgerHQ/app-ethereum/blob/develop/doc/ethapp.adoc.
+const (
+	claEthereum = 0xe0
+
+	insGetPublicKey     = 0x02
+	insSignTypedMessage = 0x0c
+
+	p1NonConfirm  = 0x00
+	p1Confirm     = 0x01
+	p2NoChainCode = 0x00
+
+	// bip44PathLength is the number of elements of a BIP44 derivation path.
+	bip44PathLength = 5
+	// hardened is the offset of hardened BIP32 derivation path elements.
+	hardened = 0x80000000
+	// eip712SignBytesLength is the length of EIP-712 sign bytes, i.e. the prefix followed by the
+	// domain separator and message hash.
+	eip712SignBytesLength = 2 + 2*common.HashLength
+)
+
+// eip712Prefix is the EIP-191 prefix of EIP-712 sign bytes.
+var eip712Prefix = []byte{0x19, 0x01}
+
+// Compile-time type assertion.
+var _ sdkledger.SECP256K1 = (*EthSecp256k1)(nil)
+
+var (
+	// ErrInvalidPath is returned for derivation paths that the Ethereum app does not derive.
+	ErrInvalidPath = errors.New("invalid ledger derivation path")
+	// ErrInvalidResponse is returned when the Ledger device returns a malformed response.
+	ErrInvalidResponse = errors.New("invalid ledger response")
+	// ErrUnsupportedSignBytes is returned for sign bytes that are not EIP-712 encoded.
+	ErrUnsupportedSignBytes = errors.New(
+		"the Ledger Ethereum app only signs EIP-712 sign bytes, sign with `evm eip712 --ledger`",
+	)
+)
+
+// Device is a connection to a Ledger device, exchanging APDU commands with the open app.
+type Device interface {
+	// Exchange sends the given APDU command and returns the response data, or an error if the
+	// device returns an error status word.
+	Exchange(command []byte) ([]byte, error)
+	// Close closes the connection to the device.
+	Close() error
+}
+
+// EthSecp256k1 implements the Cosmos SDK's Ledger interface for eth_secp256k1 keys on top of the
+// Ledger Ethereum app, so that keys on a Ledger can be used through the Cosmos keyring. As the
+// Ethereum app only signs transactions and EIP-712 typed data, only the sign bytes of the EIP-712
+// sign mode (see signing.EIP712SignModeHandler) can be signed.
+type EthSecp256k1 struct {
+	device Device
+}
+
+// NewEthSecp256k1 returns an EthSecp256k1 using the Ethereum app of the given device.
+func NewEthSecp256k1(device Device) *EthSecp256k1 {
+	return &EthSecp256k1{device: device}
+}
+
+// Close closes the connection to the device.
+func (l *EthSecp256k1) Close() error {
+	return l.device.Close()
+}
+
+// GetPublicKeySECP256K1 returns the uncompressed public key at the given BIP44 path, without
+// user confirmation.
+func (l *EthSecp256k1) GetPublicKeySECP256K1(path []uint32) ([]byte, error) {
+	pubKey, _, err := l.getPublicKey(path, p1NonConfirm)
+	return pubKey, err
+}
+
+// GetAddressPubKeySECP256K1 returns the compressed public key at the given BIP44 path and its
+// bech32 address with the given prefix, once the user confirms the Ethereum address on the
+// device.
+func (l *EthSecp256k1) GetAddressPubKeySECP256K1(
+	path []uint32, hrp string,
+) ([]byte, string, error) {
+	pubKey, address, err := l.getPublicKey(path, p1Confirm)
+	if err != nil {
+		return nil, "", err
+	}
+
+	ecdsaPubKey, err := ethcrypto.UnmarshalPubkey(pubKey)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+	}
+	if ethcrypto.PubkeyToAddress(*ecdsaPubKey) != address {
+		return nil, "", fmt.Errorf(
+			"%w: address %s does not match public key", ErrInvalidResponse, address.Hex(),
+		)
+	}
+
+	bech32Address, err := bech32.ConvertAndEncode(hrp, address.Bytes())
+	if err != nil {
+		return nil, "", err
+	}
+	return ethcrypto.CompressPubkey(ecdsaPubKey), bech32Address, nil
+}
+
+// SignSECP256K1 signs the given EIP-712 sign bytes, i.e. `"\x19\x01" ‖ domainSeparator ‖
+// messageHash`, with the key at the given BIP44 path, once the user confirms on the device. It
+// returns the signature of their keccak256 hash in the [R || S || V] format, with V being the 0
+// or 1 recovery ID. Sign bytes of any other sign mode are rejected with ErrUnsupportedSignBytes.
+func (l *EthSecp256k1) SignSECP256K1(path []uint32, signBytes []byte, _ byte) ([]byte, error) {
+	if len(signBytes) != eip712SignBytesLength || !bytes.HasPrefix(signBytes, eip712Prefix) {
+		return nil, ErrUnsupportedSignBytes
+	}
+	bip32Path, err := encodePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// The data is the path followed by the domain separator and message hash.
+	data := append(bip32Path, signBytes[len(eip712Prefix):]...) //nolint:gocritic // new slice.
+	res, err := l.device.Exchange(apdu(insSignTypedMessage, p1NonConfirm, data))
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is V || R || S, with V being 27 or 28.
+	if len(res) != ethcrypto.SignatureLength {
+		return nil, fmt.Errorf("%w: signature of length %d", ErrInvalidResponse, len(res))
+	}
+	sig := make([]byte, ethcrypto.SignatureLength)
+	copy(sig, res[1:])
+	sig[ethcrypto.RecoveryIDOffset] = res[0] - 27 //nolint:gomnd // legacy V offset.
+	return sig, nil
+}
+
+// getPublicKey returns the uncompressed public key and address at the given BIP44 path.
+func (l *EthSecp256k1) getPublicKey(
+	path []uint32, p1 byte,
+) ([]byte, common.Address, error) {
+	bip32Path, err := encodePath(path)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	res, err := l.device.Exchange(apdu(insGetPublicKey, p1, bip32Path))
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	// The response is len(pubKey) || pubKey || len(address) || address, with the address being
+	// hex encoded without the 0x prefix.
+	if len(res) < 1 || len(res) < 1+int(res[0])+1 {
+		return nil, common.Address{}, fmt.Errorf(
+			"%w: public key response too short", ErrInvalidResponse,
+		)
+	}
+	pubKey, rest := res[1:1+res[0]], res[1+res[0]:]
+	if len(rest) < 1+int(rest[0]) || !common.IsHexAddress(string(rest[1:1+rest[0]])) {
+		return nil, common.Address{}, fmt.Errorf("%w: invalid address", ErrInvalidResponse)
+	}
+	return pubKey, common.HexToAddress(string(rest[1 : 1+rest[0]])), nil
+}
+
+// encodePath returns the BIP32 encoding of the given BIP44 path, as derived by the Cosmos SDK,
+// i.e. with the purpose, coin type and account hardened. Only the Ethereum coin type is accepted.
+func encodePath(path []uint32) ([]byte, error) {
+	if len(path) != bip44PathLength {
+		return nil, fmt.Errorf("%w: expected %d elements, got %d", ErrInvalidPath, bip44PathLength,
+			len(path))
+	}
+	if path[1] != accounts.Bip44CoinType {
+		return nil, fmt.Errorf(
+			"%w: coin type %d is not the Ethereum coin type %d, set --coin-type %d",
+			ErrInvalidPath, path[1], accounts.Bip44CoinType, accounts.Bip44CoinType,
+		)
+	}
+
+	bz := make([]byte, 1+4*bip44PathLength) //nolint:gomnd // 4 bytes per element.
+	bz[0] = bip44PathLength
+	for i, element := range path {
+		if i < 3 { //nolint:gomnd // purpose, coin type and account are hardened.
+			element |= hardened
+		}
+		binary.BigEndian.PutUint32(bz[1+4*i:], element)
+	}
+	return bz, nil
+}
+
+// apdu returns the APDU command of the Ethereum app with the given instruction, P1 and data.
+func apdu(ins, p1 byte, data []byte) []byte {
+	return append([]byte{claEthereum, ins, p1, p2NoChainCode, byte(len(data))}, data...)
+}
+
+// discover connects to a Ledger device, set depending on the ledger build tag.
+var discover func() (sdkledger.SECP256K1, error)
+
+// DiscoverEthSecp256k1 connects to a Ledger device running the Ethereum app. It is meant to be
+// used as the keyring's LedgerDerivation.
+func DiscoverEthSecp256k1() (sdkledger.SECP256K1, error) {
+	return discover()
+}
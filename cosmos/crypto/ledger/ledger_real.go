@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+//go:build cgo && ledger
+// +build cgo,ledger
+
+package ledger
+
+import (
+	sdkledger "github.com/cosmos/cosmos-sdk/crypto/ledger"
+
+	ledger "github.com/zondax/ledger-go"
+)
+
+// With ledger support (build tag) enabled, which implies a CGO dependency, discover connects to
+// the first Ledger device found over USB.
+func init() {
+	discover = func() (sdkledger.SECP256K1, error) {
+		device, err := ledger.NewLedgerAdmin().Connect(0)
+		if err != nil {
+			return nil, err
+		}
+		return NewEthSecp256k1(device), nil
+	}
+}
@@ -0,0 +1,285 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package ledger_test
+
+import (
+	"crypto/ecdsa"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	cryptocodec "github.com/berachain/polaris/cosmos/crypto/codec"
+	"github.com/berachain/polaris/cosmos/crypto/hd"
+	polarkeyring "github.com/berachain/polaris/cosmos/crypto/keyring"
+	"github.com/berachain/polaris/cosmos/crypto/keys/ethsecp256k1"
+	"github.com/berachain/polaris/cosmos/crypto/ledger"
+	signinglib "github.com/berachain/polaris/cosmos/lib/signing"
+	"github.com/berachain/polaris/eth/accounts"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkhd "github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdkledger "github.com/cosmos/cosmos-sdk/crypto/ledger"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/go-bip39"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestLedger(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/crypto/ledger")
+}
+
+// mockDevice is a Ledger device running the Ethereum app, with keys derived from the mnemonic
+// used by the Cosmos SDK's ledger mock.
+type mockDevice struct {
+	closed   bool
+	mnemonic string
+}
+
+func (d *mockDevice) Exchange(command []byte) ([]byte, error) {
+	if len(command) < 5 || command[0] != 0xe0 || int(command[4]) != len(command[5:]) {
+		return nil, errors.New("invalid APDU")
+	}
+	ins, data := command[1], command[5:]
+
+	key, data, err := d.derive(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch ins {
+	case 0x02:
+		pubKey := ethcrypto.FromECDSAPub(&key.PublicKey)
+		address := []byte(ethcrypto.PubkeyToAddress(key.PublicKey).Hex()[2:])
+		res := append([]byte{byte(len(pubKey))}, pubKey...)
+		res = append(res, byte(len(address)))
+		return append(res, address...), nil
+	case 0x0c:
+		if len(data) != 64 {
+			return nil, errors.New("invalid typed data")
+		}
+		digest := ethcrypto.Keccak256(append([]byte{0x19, 0x01}, data...))
+		sig, err := ethcrypto.Sign(digest, key)
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{sig[64] + 27}, sig[:64]...), nil
+	default:
+		return nil, fmt.Errorf("unknown instruction %#x", ins)
+	}
+}
+
+func (d *mockDevice) Close() error {
+	d.closed = true
+	return nil
+}
+
+// derive returns the key at the BIP32 path at the start of the given APDU data.
+func (d *mockDevice) derive(data []byte) (*ecdsa.PrivateKey, []byte, error) {
+	if len(data) < 1 || len(data) < 1+4*int(data[0]) {
+		return nil, nil, errors.New("invalid path")
+	}
+	elements := make([]string, data[0])
+	for i := range elements {
+		element := binary.BigEndian.Uint32(data[1+4*i:])
+		if element >= 0x80000000 {
+			elements[i] = fmt.Sprintf("%d'", element-0x80000000)
+		} else {
+			elements[i] = fmt.Sprintf("%d", element)
+		}
+	}
+
+	mnemonic := testdata.TestMnemonic
+	if d.mnemonic != "" {
+		mnemonic = d.mnemonic
+	}
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	master, chainCode := sdkhd.ComputeMastersFromSeed(seed)
+	bz, err := sdkhd.DerivePrivateKeyForPath(master, chainCode, strings.Join(elements, "/"))
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := ethcrypto.ToECDSA(bz)
+	return key, data[1+4*int(data[0]):], err
+}
+
+// bip39Mnemonic returns a new random mnemonic.
+func bip39Mnemonic() string {
+	entropy, err := bip39.NewEntropy(256) //nolint:gomnd // 24 words.
+	Expect(err).NotTo(HaveOccurred())
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	Expect(err).NotTo(HaveOccurred())
+	return mnemonic
+}
+
+var _ = Describe("EthSecp256k1", func() {
+	var (
+		device *mockDevice
+		dir    string
+		kr     keyring.Keyring
+		pubKey *ethsecp256k1.PubKey
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "ledger_test")
+		Expect(err).NotTo(HaveOccurred())
+
+		interfaceRegistry := codectypes.NewInterfaceRegistry()
+		std.RegisterInterfaces(interfaceRegistry)
+		cryptocodec.RegisterInterfaces(interfaceRegistry)
+		cdc := codec.NewProtoCodec(interfaceRegistry)
+
+		device = &mockDevice{}
+		kr, err = keyring.New(
+			"accounts", keyring.BackendTest, dir, strings.NewReader(""), cdc,
+			polarkeyring.OnlyEthSecp256k1Option(),
+			func(options *keyring.Options) {
+				options.LedgerDerivation = func() (sdkledger.SECP256K1, error) {
+					return ledger.NewEthSecp256k1(device), nil
+				}
+			},
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		bz, err := hd.EthSecp256k1.Derive()(testdata.TestMnemonic, "", accounts.BIP44HDPath)
+		Expect(err).NotTo(HaveOccurred())
+		pubKey, _ = hd.EthSecp256k1.Generate()(bz).PubKey().(*ethsecp256k1.PubKey)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("should save a ledger key with the Ethereum coin type", func() {
+		record, err := kr.SaveLedgerKey("foo", hd.EthSecp256k1, "cosmos",
+			accounts.Bip44CoinType, 0, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(record.GetType()).To(Equal(keyring.TypeLedger))
+		Expect(record.GetPubKey()).To(Equal(pubKey))
+		Expect(device.closed).To(BeTrue())
+	})
+
+	It("should reject coin types other than the Ethereum one", func() {
+		_, err := kr.SaveLedgerKey("foo", hd.EthSecp256k1, "cosmos", sdk.CoinType, 0, 0)
+		Expect(err).To(HaveOccurred())
+
+		_, err = ledger.NewEthSecp256k1(device).GetPublicKeySECP256K1(
+			sdkhd.NewFundraiserParams(0, sdk.CoinType, 0).DerivationPath(),
+		)
+		Expect(err).To(MatchError(ledger.ErrInvalidPath))
+	})
+
+	It("should sign EIP-712 sign bytes", func() {
+		_, err := kr.SaveLedgerKey("foo", hd.EthSecp256k1, "cosmos",
+			accounts.Bip44CoinType, 0, 0)
+		Expect(err).NotTo(HaveOccurred())
+
+		signBytes := append([]byte{0x19, 0x01}, ethcrypto.Keccak256([]byte("domain"))...)
+		signBytes = append(signBytes, ethcrypto.Keccak256([]byte("message"))...)
+		sig, signer, err := kr.Sign("foo", signBytes, signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(signer).To(Equal(pubKey))
+		Expect(pubKey.VerifySignature(signBytes, sig)).To(BeTrue())
+
+		recovered, err := ethcrypto.SigToPub(ethcrypto.Keccak256(signBytes), sig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ethcrypto.PubkeyToAddress(*recovered)).To(Equal(
+			common.BytesToAddress(pubKey.Address()),
+		))
+	})
+
+	It("should sign the EIP-712 sign mode through the keyring", func() {
+		_, err := kr.SaveLedgerKey("foo", hd.EthSecp256k1, "cosmos",
+			accounts.Bip44CoinType, 0, 0)
+		Expect(err).NotTo(HaveOccurred())
+
+		signBytes := append([]byte{0x19, 0x01}, ethcrypto.Keccak256([]byte("domain"))...)
+		signBytes = append(signBytes, ethcrypto.Keccak256([]byte("message"))...)
+		_, _, err = kr.Sign("foo", signBytes, signinglib.TxSignModeEIP712)
+		Expect(err).To(MatchError(keyring.ErrInvalidSignMode))
+
+		sig, signer, err := polarkeyring.NewEIP712Keyring(kr).SignByAddress(
+			sdk.AccAddress(pubKey.Address()), signBytes, signinglib.TxSignModeEIP712,
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(signer).To(Equal(pubKey))
+		Expect(pubKey.VerifySignature(signBytes, sig)).To(BeTrue())
+	})
+
+	It("should not sign with a device that derives another key", func() {
+		_, err := kr.SaveLedgerKey("foo", hd.EthSecp256k1, "cosmos",
+			accounts.Bip44CoinType, 0, 0)
+		Expect(err).NotTo(HaveOccurred())
+		device.mnemonic = bip39Mnemonic()
+
+		signBytes := append([]byte{0x19, 0x01}, make([]byte, 64)...)
+		_, _, err = polarkeyring.NewEIP712Keyring(kr).Sign(
+			"foo", signBytes, signinglib.TxSignModeEIP712,
+		)
+		Expect(err).To(MatchError(ContainSubstring("does not match the public key on the ledger")))
+	})
+
+	It("should reject sign bytes of other sign modes", func() {
+		_, err := kr.SaveLedgerKey("foo", hd.EthSecp256k1, "cosmos",
+			accounts.Bip44CoinType, 0, 0)
+		Expect(err).NotTo(HaveOccurred())
+
+		signBytes := []byte(`{"account_number":"1","chain_id":"polaris-2061"}`)
+		_, _, err = kr.Sign("foo", signBytes, signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+		Expect(err).To(MatchError(ledger.ErrUnsupportedSignBytes))
+
+		// A 66 byte message without the EIP-712 prefix is not signed either.
+		_, err = ledger.NewEthSecp256k1(device).SignSECP256K1(
+			sdkhd.NewFundraiserParams(0, accounts.Bip44CoinType, 0).DerivationPath(),
+			make([]byte, 66), 0,
+		)
+		Expect(err).To(MatchError(ledger.ErrUnsupportedSignBytes))
+	})
+
+	It("should return the compressed public key and bech32 address", func() {
+		compressed, address, err := ledger.NewEthSecp256k1(device).GetAddressPubKeySECP256K1(
+			sdkhd.NewFundraiserParams(0, accounts.Bip44CoinType, 0).DerivationPath(), "cosmos",
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(compressed).To(Equal(pubKey.Key))
+		Expect(address).To(Equal(sdk.MustBech32ifyAddressBytes("cosmos", pubKey.Address())))
+	})
+})
@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package signing
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strconv"
+
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/x/tx/decode"
+	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/aminojson"
+
+	txsigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const (
+	// SignModeEIP712 is the sign mode of Cosmos transactions signed as EIP-712 typed data, e.g. by
+	// browser wallets with `eth_signTypedData_v4` or by the Ledger Ethereum app. It is not one of
+	// the SDK's sign modes, as SIGN_MODE_EIP_191 signs the Amino JSON of a transaction as a
+	// personal message, which is a different scheme.
+	SignModeEIP712 = signingv1beta1.SignMode(712)
+	// TxSignModeEIP712 is SignModeEIP712 as set in the mode info of transaction signatures.
+	TxSignModeEIP712 = txsigning.SignMode(SignModeEIP712)
+)
+
+// ChainIDFn returns the EVM chain ID that Cosmos transactions are signed for.
+type ChainIDFn func(ctx context.Context) (*big.Int, error)
+
+// StaticChainID returns a ChainIDFn of the given EVM chain ID.
+func StaticChainID(chainID *big.Int) ChainIDFn {
+	return func(context.Context) (*big.Int, error) { return chainID, nil }
+}
+
+const (
+	// eip712DomainName is the name of the EIP-712 domain of Cosmos transactions.
+	eip712DomainName = "Polaris"
+	// eip712DomainVersion is the version of the EIP-712 domain of Cosmos transactions.
+	eip712DomainVersion = "1"
+	// eip712PrimaryType is the EIP-712 type of Cosmos transactions.
+	eip712PrimaryType = "Tx"
+)
+
+// eip712Types are the EIP-712 types that any Cosmos transaction is rendered as. Messages are
+// rendered with their type URL and Amino JSON, which is what hardware wallets show as well.
+var eip712Types = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+	},
+	eip712PrimaryType: {
+		{Name: "chainId", Type: "string"},
+		{Name: "accountNumber", Type: "uint64"},
+		{Name: "sequence", Type: "uint64"},
+		{Name: "timeoutHeight", Type: "uint64"},
+		{Name: "memo", Type: "string"},
+		{Name: "fee", Type: "Fee"},
+		{Name: "msgs", Type: "Msg[]"},
+	},
+	"Fee": {
+		{Name: "amount", Type: "Coin[]"},
+		{Name: "gas", Type: "uint64"},
+		{Name: "payer", Type: "string"},
+		{Name: "granter", Type: "string"},
+	},
+	"Coin": {
+		{Name: "denom", Type: "string"},
+		{Name: "amount", Type: "string"},
+	},
+	"Msg": {
+		{Name: "typeUrl", Type: "string"},
+		{Name: "value", Type: "string"},
+	},
+}
+
+// Compile-time type assertion.
+var _ signing.SignModeHandler = (*EIP712SignModeHandler)(nil)
+
+// EIP712SignModeHandler renders Cosmos transactions as EIP-712 typed data, in the domain of the
+// EVM chain ID, so that they can be signed by Ethereum wallets with eth_secp256k1 keys.
+type EIP712SignModeHandler struct {
+	chainID      ChainIDFn
+	fileResolver signing.ProtoFileResolver
+	encoder      aminojson.Encoder
+}
+
+// NewEIP712SignModeHandler returns an EIP712SignModeHandler for the EVM chain ID returned by the
+// given function, which on a node must be the chain ID of the on-chain chain config.
+func NewEIP712SignModeHandler(chainID ChainIDFn) *EIP712SignModeHandler {
+	return &EIP712SignModeHandler{
+		chainID:      chainID,
+		fileResolver: proto.HybridResolver,
+		encoder: aminojson.NewEncoder(aminojson.EncoderOptions{
+			FileResolver: proto.HybridResolver,
+			TypeResolver: protoregistry.GlobalTypes,
+		}),
+	}
+}
+
+// Mode implements signing.SignModeHandler.
+func (h *EIP712SignModeHandler) Mode() signingv1beta1.SignMode {
+	return SignModeEIP712
+}
+
+// GetSignBytes implements signing.SignModeHandler. It returns the EIP-191 encoding of the typed
+// data of the transaction, i.e. `"\x19\x01" ‖ domainSeparator ‖ hashStruct(tx)`, whose keccak256
+// hash is signed by eth_secp256k1 keys.
+func (h *EIP712SignModeHandler) GetSignBytes(
+	ctx context.Context, signerData signing.SignerData, txData signing.TxData,
+) ([]byte, error) {
+	typedData, err := h.GetTypedData(ctx, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+	_, rawData, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(rawData), nil
+}
+
+// GetTypedData returns the EIP-712 typed data of the given transaction, to be signed by a wallet,
+// e.g. with `eth_signTypedData_v4`.
+func (h *EIP712SignModeHandler) GetTypedData(
+	ctx context.Context, signerData signing.SignerData, txData signing.TxData,
+) (apitypes.TypedData, error) {
+	chainID, err := h.chainID(ctx)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+	body := txData.Body
+	if _, err = decode.RejectUnknownFields(
+		txData.BodyBytes, body.ProtoReflect().Descriptor(), false, h.fileResolver,
+	); err != nil {
+		return apitypes.TypedData{}, err
+	}
+	if len(body.ExtensionOptions) > 0 || len(body.NonCriticalExtensionOptions) > 0 {
+		return apitypes.TypedData{}, errors.New(
+			"EIP-712 sign mode does not support protobuf extension options: invalid request",
+		)
+	}
+	fee := txData.AuthInfo.Fee
+	if fee == nil {
+		return apitypes.TypedData{}, errors.New("fee cannot be nil")
+	}
+
+	amount := make([]interface{}, len(fee.Amount))
+	for i, coin := range fee.Amount {
+		amount[i] = map[string]interface{}{"denom": coin.Denom, "amount": coin.Amount}
+	}
+	msgs := make([]interface{}, len(body.Messages))
+	for i, msg := range body.Messages {
+		var value []byte
+		if value, err = h.encoder.Marshal(msg); err != nil {
+			return apitypes.TypedData{}, err
+		}
+		msgs[i] = map[string]interface{}{"typeUrl": msg.TypeUrl, "value": string(value)}
+	}
+
+	return apitypes.TypedData{
+		Types:       eip712Types,
+		PrimaryType: eip712PrimaryType,
+		Domain: apitypes.TypedDataDomain{
+			Name:    eip712DomainName,
+			Version: eip712DomainVersion,
+			ChainId: (*math.HexOrDecimal256)(chainID),
+		},
+		Message: apitypes.TypedDataMessage{
+			"chainId":       signerData.ChainID,
+			"accountNumber": strconv.FormatUint(signerData.AccountNumber, 10),
+			"sequence":      strconv.FormatUint(signerData.Sequence, 10),
+			"timeoutHeight": strconv.FormatUint(body.TimeoutHeight, 10),
+			"memo":          body.Memo,
+			"fee": map[string]interface{}{
+				"amount":  amount,
+				"gas":     strconv.FormatUint(fee.GasLimit, 10),
+				"payer":   fee.Payer,
+				"granter": fee.Granter,
+			},
+			"msgs": msgs,
+		},
+	}, nil
+}
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package signing_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	sdkmath "cosmossdk.io/math"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	signinglib "github.com/berachain/polaris/cosmos/lib/signing"
+	"github.com/berachain/polaris/cosmos/testutil"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EIP712SignModeHandler", func() {
+	var (
+		chainID    *big.Int
+		handler    *signinglib.EIP712SignModeHandler
+		txConfig   client.TxConfig
+		builder    client.TxBuilder
+		signerData txsigning.SignerData
+	)
+
+	BeforeEach(func() {
+		chainID = big.NewInt(2061)
+		handler = signinglib.NewEIP712SignModeHandler(
+			func(context.Context) (*big.Int, error) { return chainID, nil },
+		)
+		encCfg := testutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{})
+		txConfig = authtx.NewTxConfig(encCfg.Codec, authtx.DefaultSignModes, handler)
+
+		from := sdk.AccAddress(testutil.Alice.Bytes())
+		builder = txConfig.NewTxBuilder()
+		Expect(builder.SetMsgs(banktypes.NewMsgSend(
+			from, sdk.AccAddress(testutil.Bob.Bytes()),
+			sdk.NewCoins(sdk.NewCoin("abera", sdkmath.NewInt(100))),
+		))).To(Succeed())
+		builder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("abera", sdkmath.NewInt(10))))
+		builder.SetGasLimit(100_000)
+		builder.SetMemo("memo")
+		signerData = txsigning.SignerData{
+			Address:       from.String(),
+			ChainID:       "polaris-2061",
+			AccountNumber: 7,
+			Sequence:      3,
+		}
+	})
+
+	txData := func() txsigning.TxData {
+		return builder.GetTx().(authsigning.V2AdaptableTx).GetSigningTxData()
+	}
+
+	It("should have its own sign mode", func() {
+		Expect(handler.Mode()).To(Equal(signinglib.SignModeEIP712))
+		Expect(handler.Mode()).ToNot(Equal(signingv1beta1.SignMode_SIGN_MODE_EIP_191))
+	})
+
+	It("should render the transaction as typed data in the domain of the EVM chain ID", func() {
+		typedData, err := handler.GetTypedData(context.Background(), signerData, txData())
+		Expect(err).ToNot(HaveOccurred())
+		Expect((*big.Int)(typedData.Domain.ChainId)).To(Equal(chainID))
+		Expect(typedData.Domain.Name).To(Equal("Polaris"))
+		Expect(typedData.Message["chainId"]).To(Equal("polaris-2061"))
+		Expect(typedData.Message["accountNumber"]).To(Equal("7"))
+		Expect(typedData.Message["sequence"]).To(Equal("3"))
+		Expect(typedData.Message["memo"]).To(Equal("memo"))
+
+		msgs, ok := typedData.Message["msgs"].([]interface{})
+		Expect(ok).To(BeTrue())
+		Expect(msgs).To(HaveLen(1))
+		Expect(msgs[0]).To(HaveKeyWithValue("typeUrl", "/cosmos.bank.v1beta1.MsgSend"))
+	})
+
+	It("should return the EIP-712 encoding of the typed data as sign bytes", func() {
+		typedData, err := handler.GetTypedData(context.Background(), signerData, txData())
+		Expect(err).ToNot(HaveOccurred())
+		hash, _, err := apitypes.TypedDataAndHash(typedData)
+		Expect(err).ToNot(HaveOccurred())
+
+		signBytes, err := txConfig.SignModeHandler().GetSignBytes(
+			context.Background(), signinglib.SignModeEIP712, signerData, txData(),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(signBytes[:2]).To(Equal([]byte{0x19, 0x01}))
+		Expect(crypto.Keccak256(signBytes)).To(Equal([]byte(hash)))
+	})
+
+	It("should sign for the chain ID at the time of signing", func() {
+		before, err := handler.GetSignBytes(context.Background(), signerData, txData())
+		Expect(err).ToNot(HaveOccurred())
+
+		chainID = big.NewInt(80085)
+		after, err := handler.GetSignBytes(context.Background(), signerData, txData())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(after).ToNot(Equal(before))
+
+		static := signinglib.NewEIP712SignModeHandler(signinglib.StaticChainID(chainID))
+		Expect(static.GetSignBytes(context.Background(), signerData, txData())).To(Equal(after))
+	})
+
+	It("should return the error of the chain ID function", func() {
+		errNoChainID := errors.New("no chain ID")
+		handler = signinglib.NewEIP712SignModeHandler(
+			func(context.Context) (*big.Int, error) { return nil, errNoChainID },
+		)
+		_, err := handler.GetSignBytes(context.Background(), signerData, txData())
+		Expect(err).To(MatchError(errNoChainID))
+	})
+
+	It("should reject transactions with extension options", func() {
+		option, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{})
+		Expect(err).ToNot(HaveOccurred())
+		builder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(option)
+
+		_, err = handler.GetSignBytes(context.Background(), signerData, txData())
+		Expect(err).To(MatchError(ContainSubstring("extension options")))
+	})
+})
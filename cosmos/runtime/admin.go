@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package runtime
+
+import (
+	"errors"
+
+	"github.com/berachain/polaris/eth/core"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// errContextHeightsUnavailable is returned when the state plugin factory does not report the
+// heights of its contexts.
+var errContextHeightsUnavailable = errors.New("state context heights are not available")
+
+// contextHeightsProvider is implemented by state plugin factories that report the heights of the
+// contexts that state plugins are created with.
+type contextHeightsProvider interface {
+	ContextHeights() map[string]int64
+}
+
+// RPCModules are the JSON-RPC modules exposed on each unauthenticated endpoint.
+type RPCModules struct {
+	HTTP []string `json:"http"`
+	WS   []string `json:"ws"`
+}
+
+// AdminAPI is the collection of admin RPC API methods for node operators. Its methods are served
+// as polaris_admin* on the JWT authenticated auth port only.
+type AdminAPI struct {
+	p   *Polaris
+	spf core.StatePluginFactory
+}
+
+// newAdminAPIs returns the authenticated admin API of the given runtime.
+func newAdminAPIs(p *Polaris, spf core.StatePluginFactory) []rpc.API {
+	return []rpc.API{{
+		Namespace:     "polaris",
+		Service:       &AdminAPI{p: p, spf: spf},
+		Authenticated: true,
+	}}
+}
+
+// AdminFlushTxPool evicts every transaction from the txpool and the CometBFT mempool, returning
+// their hashes.
+func (api *AdminAPI) AdminFlushTxPool() []common.Hash {
+	pending, queued := api.p.gethTxPool.Content()
+	var txHashes []common.Hash
+	for _, txs := range pending {
+		txHashes = appendTxHashes(txHashes, txs)
+	}
+	for _, txs := range queued {
+		txHashes = appendTxHashes(txHashes, txs)
+	}
+	api.p.WrappedTxPool.Evict(txHashes...)
+	return txHashes
+}
+
+// AdminEvictTxsFrom evicts every transaction of the given senders from the txpool and the
+// CometBFT mempool, returning their hashes.
+func (api *AdminAPI) AdminEvictTxsFrom(senders []common.Address) []common.Hash {
+	var txHashes []common.Hash
+	for _, sender := range senders {
+		pending, queued := api.p.gethTxPool.ContentFrom(sender)
+		txHashes = appendTxHashes(appendTxHashes(txHashes, pending), queued)
+	}
+	api.p.WrappedTxPool.Evict(txHashes...)
+	return txHashes
+}
+
+// AdminLogLevel returns the minimum levels of geth logs per geth subsystem, e.g.
+// "*:info,txpool:debug", where empty emits every geth log.
+func (api *AdminAPI) AdminLogLevel() string {
+	return api.p.gethLogLevels.Load().String()
+}
+
+// AdminSetLogLevel sets the minimum levels of geth logs per geth subsystem, in the format of
+// geth-log-level in app.toml, e.g. "txpool:debug,*:info", with the levels "trace", "debug",
+// "info", "warn", "error" and "crit". The Cosmos log level still applies.
+func (api *AdminAPI) AdminSetLogLevel(levels string) error {
+	api.p.reloadMu.Lock()
+	defer api.p.reloadMu.Unlock()
+	if err := api.p.setGethLogLevel(levels); err != nil {
+		return err
+	}
+	api.p.logger.Info("set geth log level", "levels", levels)
+	return nil
+}
+
+// AdminStateContextHeights returns the block heights of the contexts that the mining, chain
+// insertion, finalization and latest query state plugins are created with.
+func (api *AdminAPI) AdminStateContextHeights() (map[string]int64, error) {
+	provider, ok := api.spf.(contextHeightsProvider)
+	if !ok {
+		return nil, errContextHeightsUnavailable
+	}
+	return provider.ContextHeights(), nil
+}
+
+// AdminRPCModules returns the JSON-RPC modules exposed on the unauthenticated HTTP and WebSocket
+// endpoints. The geth node fixes them when its endpoints start, so changing them requires
+// updating http-modules or ws-modules in app.toml and restarting the node.
+func (api *AdminAPI) AdminRPCModules() *RPCModules {
+	api.p.reloadMu.Lock()
+	defer api.p.reloadMu.Unlock()
+	return &RPCModules{
+		HTTP: append([]string{}, api.p.cfg.Node.HTTPModules...),
+		WS:   append([]string{}, api.p.cfg.Node.WSModules...),
+	}
+}
+
+// appendTxHashes appends the hashes of the given txs to txHashes.
+func appendTxHashes(txHashes []common.Hash, txs []*ethtypes.Transaction) []common.Hash {
+	for _, tx := range txs {
+		txHashes = append(txHashes, tx.Hash())
+	}
+	return txHashes
+}
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package runtime
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/berachain/polaris/cosmos/config"
+	"github.com/berachain/polaris/cosmos/runtime/txpool"
+	"github.com/berachain/polaris/eth/core"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// heightsFactory is a state plugin factory that reports the heights of its contexts.
+type heightsFactory struct {
+	core.StatePluginFactory
+	heights map[string]int64
+}
+
+func (f *heightsFactory) ContextHeights() map[string]int64 { return f.heights }
+
+var _ = Describe("AdminAPI", func() {
+	var (
+		p       *Polaris
+		api     *AdminAPI
+		backend *fakeBackend
+		alice   = common.HexToAddress("0xa11ce")
+		bob     = common.HexToAddress("0xb0b")
+	)
+
+	newTx := func(nonce uint64) *ethtypes.Transaction {
+		return ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: nonce, GasPrice: big.NewInt(10)})
+	}
+
+	BeforeEach(func() {
+		backend = &fakeBackend{
+			pending: map[common.Address][]*ethtypes.Transaction{
+				alice: {newTx(0), newTx(1)},
+				bob:   {newTx(2)},
+			},
+			queued: map[common.Address][]*ethtypes.Transaction{
+				alice: {newTx(3)},
+			},
+		}
+		cfg := config.DefaultPolarisConfig()
+		cfg.GethLogLevel = "*:info"
+		cfg.Polar.LegacyTxPool.PriceLimit = 1
+		cfg.Polar.LegacyTxPool.Lifetime = 100
+		p = newTestPolaris(cfg, backend, &bytes.Buffer{})
+		api = &AdminAPI{p: p}
+	})
+
+	// insert inserts the given tx into the mempool as CometBFT does on CheckTx.
+	insert := func(ethTx *ethtypes.Transaction) error {
+		return p.WrappedTxPool.Insert(
+			sdk.Context{}.WithExecMode(sdk.ExecModeCheck), newSdkTx(ethTx),
+		)
+	}
+
+	It("should flush every tx of the txpool", func() {
+		all := []common.Hash{
+			newTx(0).Hash(), newTx(1).Hash(), newTx(2).Hash(), newTx(3).Hash(),
+		}
+		Expect(api.AdminFlushTxPool()).To(ConsistOf(all))
+		Expect(backend.removed).To(ConsistOf(all))
+
+		// The flushed txs are ejected from the CometBFT mempool and are not readmitted.
+		Expect(recheckTx(p, newTx(2), 1)).To(MatchError(txpool.ErrTxEvicted))
+		Expect(insert(newTx(0))).To(MatchError(txpool.ErrTxEvicted))
+	})
+
+	It("should evict the txs of the given senders", func() {
+		evicted := []common.Hash{newTx(0).Hash(), newTx(1).Hash(), newTx(3).Hash()}
+		Expect(api.AdminEvictTxsFrom([]common.Address{alice})).To(ConsistOf(evicted))
+		Expect(backend.removed).To(ConsistOf(evicted))
+
+		Expect(recheckTx(p, newTx(3), 1)).To(MatchError(txpool.ErrTxEvicted))
+		Expect(insert(newTx(1))).To(MatchError(txpool.ErrTxEvicted))
+		// The txs of other senders are kept.
+		Expect(recheckTx(p, newTx(2), 1)).To(Succeed())
+	})
+
+	It("should evict nothing for senders without txs", func() {
+		Expect(api.AdminEvictTxsFrom([]common.Address{{0x1}})).To(BeEmpty())
+		Expect(backend.removed).To(BeEmpty())
+	})
+
+	It("should get and set the geth log level", func() {
+		Expect(api.AdminLogLevel()).To(Equal("*:info"))
+		Expect(api.AdminSetLogLevel("*:warn,txpool:debug")).To(Succeed())
+		Expect(api.AdminLogLevel()).To(Equal("*:warn,txpool:debug"))
+		Expect(p.cfg.GethLogLevel).To(Equal("*:warn,txpool:debug"))
+
+		Expect(api.AdminSetLogLevel("txpool:loud")).ToNot(Succeed())
+		Expect(api.AdminLogLevel()).To(Equal("*:warn,txpool:debug"))
+	})
+
+	It("should report the heights of the state contexts", func() {
+		_, err := api.AdminStateContextHeights()
+		Expect(err).To(MatchError(errContextHeightsUnavailable))
+
+		heights := map[string]int64{"miner": 5, "latest-query": 4}
+		api.spf = &heightsFactory{heights: heights}
+		Expect(api.AdminStateContextHeights()).To(Equal(heights))
+	})
+
+	It("should report copies of the RPC modules", func() {
+		p.cfg.Node.HTTPModules = []string{"eth", "net"}
+		p.cfg.Node.WSModules = []string{"eth"}
+		modules := api.AdminRPCModules()
+		Expect(modules).To(Equal(&RPCModules{HTTP: []string{"eth", "net"}, WS: []string{"eth"}}))
+
+		modules.HTTP[0] = "debug"
+		Expect(p.cfg.Node.HTTPModules).To(Equal([]string{"eth", "net"}))
+	})
+})
@@ -29,6 +29,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/berachain/polaris/cosmos/crypto/keys/ethsecp256k1"
+	signinglib "github.com/berachain/polaris/cosmos/lib/signing"
 	"github.com/berachain/polaris/cosmos/runtime/txpool"
 	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
 
@@ -84,13 +85,18 @@ func (ah *Provider) AnteHandler() func(
 }
 
 // EthSecp256k1SigVerificationGasConsumer is a function that consumes gas for the verification
-// of an Ethereum Secp256k1 signature.
+// of an Ethereum Secp256k1 signature. Signatures over EIP-712 typed data consume twice the gas,
+// to account for rendering and hashing the transaction as typed data.
 func EthSecp256k1SigVerificationGasConsumer(
 	meter storetypes.GasMeter, sig signing.SignatureV2, params authtypes.Params,
 ) error {
 	switch sig.PubKey.(type) {
 	case *ethsecp256k1.PubKey:
 		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: ethsecp256k1")
+		if data, ok := sig.Data.(*signing.SingleSignatureData); ok &&
+			data.SignMode == signinglib.TxSignModeEIP712 {
+			meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: eip712")
+		}
 		return nil
 	default:
 		return ante.DefaultSigVerificationGasConsumer(meter, sig, params)
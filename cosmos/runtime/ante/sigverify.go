@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package ante
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	errorsmod "cosmossdk.io/errors"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/berachain/polaris/cosmos/crypto/keys/ethsecp256k1"
+	signinglib "github.com/berachain/polaris/cosmos/lib/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// NewCosmosAnteHandler returns the SDK's default ante handler for Cosmos transactions, with
+// signatures verified by the SigVerificationDecorator so that transactions signed as EIP-712
+// typed data are accepted.
+func NewCosmosAnteHandler(options ante.HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+	if options.BankKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+	if options.SignModeHandler == nil {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrLogic, "sign mode handler is required for ante builder",
+		)
+	}
+
+	return sdk.ChainAnteDecorators(
+		ante.NewSetUpContextDecorator(),
+		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper,
+			options.FeegrantKeeper, options.TxFeeChecker),
+		ante.NewSetPubKeyDecorator(options.AccountKeeper),
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
+		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+	), nil
+}
+
+// SigVerificationDecorator verifies the signatures of Cosmos transactions like the SDK's
+// SigVerificationDecorator, but also accepts eth_secp256k1 signatures over the EIP-712 typed data
+// of the transaction, which the SDK does not support as it only verifies its own sign modes.
+type SigVerificationDecorator struct {
+	ak              ante.AccountKeeper
+	signModeHandler *txsigning.HandlerMap
+	sdkDecorator    ante.SigVerificationDecorator
+}
+
+// NewSigVerificationDecorator returns a new SigVerificationDecorator.
+func NewSigVerificationDecorator(
+	ak ante.AccountKeeper, signModeHandler *txsigning.HandlerMap,
+) SigVerificationDecorator {
+	return SigVerificationDecorator{
+		ak:              ak,
+		signModeHandler: signModeHandler,
+		sdkDecorator:    ante.NewSigVerificationDecorator(ak, signModeHandler),
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator. Transactions without EIP-712 signatures are verified by
+// the SDK's SigVerificationDecorator.
+func (svd SigVerificationDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+	if !hasEIP712Signature(sigs) {
+		return svd.sdkDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+	if len(sigs) != len(signers) {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized,
+			"invalid number of signer;  expected: %d, got %d", len(signers), len(sigs))
+	}
+	adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return ctx, fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
+	}
+
+	for i, sig := range sigs {
+		var acc sdk.AccountI
+		if acc, err = ante.GetSignerAcc(ctx, svd.ak, signers[i]); err != nil {
+			return ctx, err
+		}
+		pubKey := acc.GetPubKey()
+		if !simulate && pubKey == nil {
+			return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
+		}
+		if sig.Sequence != acc.GetSequence() {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrWrongSequence,
+				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence)
+		}
+
+		// no need to verify signatures on recheck tx
+		if simulate || ctx.IsReCheckTx() {
+			continue
+		}
+
+		var accNum uint64
+		if ctx.BlockHeight() != 0 {
+			accNum = acc.GetAccountNumber()
+		}
+		anyPk, _ := codectypes.NewAnyWithValue(pubKey)
+		signerData := txsigning.SignerData{
+			Address:       acc.GetAddress().String(),
+			ChainID:       ctx.ChainID(),
+			AccountNumber: accNum,
+			Sequence:      acc.GetSequence(),
+			PubKey:        &anypb.Any{TypeUrl: anyPk.TypeUrl, Value: anyPk.Value},
+		}
+		if err = svd.verifySignature(
+			ctx, pubKey, signerData, sig.Data, adaptableTx.GetSigningTxData(),
+		); err != nil {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized,
+				"signature verification failed; please verify account number (%d) and chain-id "+
+					"(%s): (%s)", accNum, ctx.ChainID(), err.Error())
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// verifySignature verifies the given signature, which is over the EIP-712 typed data of the
+// transaction if signed with SignModeEIP712.
+func (svd SigVerificationDecorator) verifySignature(
+	ctx sdk.Context, pubKey cryptotypes.PubKey, signerData txsigning.SignerData,
+	sigData signing.SignatureData, txData txsigning.TxData,
+) error {
+	data, ok := sigData.(*signing.SingleSignatureData)
+	if !ok || data.SignMode != signinglib.TxSignModeEIP712 {
+		return authsigning.VerifySignature(
+			ctx, pubKey, signerData, sigData, svd.signModeHandler, txData,
+		)
+	}
+
+	if _, ok = pubKey.(*ethsecp256k1.PubKey); !ok {
+		return fmt.Errorf("%s requires an %s key, got %T",
+			signinglib.SignModeEIP712, ethsecp256k1.KeyType, pubKey)
+	}
+	signBytes, err := svd.signModeHandler.GetSignBytes(
+		ctx, signinglib.SignModeEIP712, signerData, txData,
+	)
+	if err != nil {
+		return err
+	}
+	if !pubKey.VerifySignature(signBytes, data.Signature) {
+		return fmt.Errorf("unable to verify single signer signature")
+	}
+	return nil
+}
+
+// hasEIP712Signature returns whether any of the given signatures is signed with SignModeEIP712.
+func hasEIP712Signature(sigs []signing.SignatureV2) bool {
+	for _, sig := range sigs {
+		if data, ok := sig.Data.(*signing.SingleSignatureData); ok &&
+			data.SignMode == signinglib.TxSignModeEIP712 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package ante_test
+
+import (
+	"context"
+	"math/big"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	cryptocodec "github.com/berachain/polaris/cosmos/crypto/codec"
+	"github.com/berachain/polaris/cosmos/crypto/keys/ethsecp256k1"
+	signinglib "github.com/berachain/polaris/cosmos/lib/signing"
+	"github.com/berachain/polaris/cosmos/runtime/ante"
+	"github.com/berachain/polaris/cosmos/testutil"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SigVerificationDecorator", func() {
+	var (
+		ctx      sdk.Context
+		ak       authkeeper.AccountKeeper
+		txConfig client.TxConfig
+		svd      ante.SigVerificationDecorator
+		priv     cryptotypes.PrivKey
+		chainID  = big.NewInt(2061)
+	)
+
+	BeforeEach(func() {
+		ctx = testutil.NewContext(log.NewTestLogger(GinkgoT())).
+			WithBlockHeight(1).WithChainID("polaris-2061")
+
+		encCfg := testutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{})
+		cryptocodec.RegisterInterfaces(encCfg.InterfaceRegistry)
+		ak = authkeeper.NewAccountKeeper(
+			encCfg.Codec, runtime.NewKVStoreService(testutil.AccKey), authtypes.ProtoBaseAccount,
+			nil, addresscodec.NewBech32Codec("cosmos"), "cosmos",
+			authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		)
+		txConfig = authtx.NewTxConfig(encCfg.Codec, authtx.DefaultSignModes,
+			signinglib.NewEIP712SignModeHandler(signinglib.StaticChainID(chainID)))
+		svd = ante.NewSigVerificationDecorator(ak, txConfig.SignModeHandler())
+
+		var err error
+		priv, err = ethsecp256k1.GenPrivKey()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	// setAccount stores the account of the given key, with its public key set.
+	setAccount := func(pubKey cryptotypes.PubKey) sdk.AccountI {
+		acc := ak.NewAccountWithAddress(ctx, sdk.AccAddress(pubKey.Address()))
+		Expect(acc.SetPubKey(pubKey)).To(Succeed())
+		ak.SetAccount(ctx, acc)
+		return acc
+	}
+
+	// signTx returns a transaction from the account, signed by the key over the sign bytes of the
+	// given sign mode, as rendered by the given handler map.
+	signTx := func(
+		acc sdk.AccountI, key cryptotypes.PrivKey, mode signing.SignMode,
+		handlers *txsigning.HandlerMap,
+	) sdk.Tx {
+		builder := txConfig.NewTxBuilder()
+		Expect(builder.SetMsgs(banktypes.NewMsgSend(
+			acc.GetAddress(), sdk.AccAddress(testutil.Bob.Bytes()),
+			sdk.NewCoins(sdk.NewCoin("abera", sdkmath.NewInt(100))),
+		))).To(Succeed())
+		builder.SetGasLimit(100_000)
+		sig := signing.SignatureV2{
+			PubKey:   acc.GetPubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: mode},
+			Sequence: acc.GetSequence(),
+		}
+		Expect(builder.SetSignatures(sig)).To(Succeed())
+
+		signBytes, err := handlers.GetSignBytes(
+			context.Background(), signingv1beta1.SignMode(mode), txsigning.SignerData{
+				Address:       acc.GetAddress().String(),
+				ChainID:       ctx.ChainID(),
+				AccountNumber: acc.GetAccountNumber(),
+				Sequence:      acc.GetSequence(),
+			}, builder.GetTx().(authsigning.V2AdaptableTx).GetSigningTxData(),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		sig.Data.(*signing.SingleSignatureData).Signature, err = key.Sign(signBytes)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(builder.SetSignatures(sig)).To(Succeed())
+		return builder.GetTx()
+	}
+
+	anteHandle := func(tx sdk.Tx) error {
+		_, err := svd.AnteHandle(ctx, tx, false,
+			func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
+		return err
+	}
+
+	It("should accept EIP-712 signatures for the EVM chain ID", func() {
+		acc := setAccount(priv.PubKey())
+		tx := signTx(acc, priv, signinglib.TxSignModeEIP712, txConfig.SignModeHandler())
+		Expect(anteHandle(tx)).To(Succeed())
+	})
+
+	It("should reject EIP-712 signatures for another EVM chain ID", func() {
+		acc := setAccount(priv.PubKey())
+		other := authtx.NewTxConfig(testutil.MakeTestEncodingConfig().Codec, authtx.DefaultSignModes,
+			signinglib.NewEIP712SignModeHandler(signinglib.StaticChainID(big.NewInt(1))))
+		tx := signTx(acc, priv, signinglib.TxSignModeEIP712, other.SignModeHandler())
+		Expect(anteHandle(tx)).To(MatchError(ContainSubstring("signature verification failed")))
+	})
+
+	It("should reject EIP-712 signatures by keys other than eth_secp256k1 keys", func() {
+		key := secp256k1.GenPrivKey()
+		acc := setAccount(key.PubKey())
+		tx := signTx(acc, key, signinglib.TxSignModeEIP712, txConfig.SignModeHandler())
+		Expect(anteHandle(tx)).To(MatchError(ContainSubstring(ethsecp256k1.KeyType)))
+	})
+
+	It("should verify the SDK's sign modes as the SDK does", func() {
+		acc := setAccount(priv.PubKey())
+		tx := signTx(acc, priv, signing.SignMode_SIGN_MODE_DIRECT, txConfig.SignModeHandler())
+		Expect(anteHandle(tx)).To(Succeed())
+
+		tx = signTx(acc, secp256k1.GenPrivKey(), signing.SignMode_SIGN_MODE_DIRECT,
+			txConfig.SignModeHandler())
+		Expect(anteHandle(tx)).To(MatchError(ContainSubstring("signature verification failed")))
+	})
+
+	It("should consume twice the gas for EIP-712 signatures", func() {
+		params := authtypes.DefaultParams()
+		consumed := func(mode signing.SignMode) storetypes.Gas {
+			meter := storetypes.NewInfiniteGasMeter()
+			Expect(ante.EthSecp256k1SigVerificationGasConsumer(meter, signing.SignatureV2{
+				PubKey: priv.PubKey(),
+				Data:   &signing.SingleSignatureData{SignMode: mode},
+			}, params)).To(Succeed())
+			return meter.GasConsumed()
+		}
+		Expect(consumed(signing.SignMode_SIGN_MODE_DIRECT)).To(Equal(params.SigVerifyCostSecp256k1))
+		Expect(consumed(signinglib.TxSignModeEIP712)).
+			To(Equal(2 * params.SigVerifyCostSecp256k1))
+	})
+})
@@ -24,6 +24,10 @@
 package chain
 
 import (
+	"errors"
+	"fmt"
+	"math/big"
+
 	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -31,6 +35,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -68,9 +73,20 @@ func (wbc *WrappedBlockchain) ProcessProposal(
 		}, nil
 	}
 
-	// Convert it to a block.
-	var block *ethtypes.Block
-	if block, err = engine.ExecutableDataToBlock(*envelope.ExecutionPayload, nil, nil); err != nil {
+	// Convert it to a block, verifying the blobs bundle and parent beacon root.
+	var (
+		block   *ethtypes.Block
+		payload = envelope.ExecutionPayload
+	)
+	if payload == nil {
+		err = errors.New("payload envelope is missing execution payload")
+	} else if err = wbc.verifyPayload(ctx, payload); err == nil {
+		block, _, err = evmtypes.EnvelopeToBlock(envelope, evmtypes.ParentBeaconRoot(
+			wbc.Blockchain.Config(), new(big.Int).SetUint64(payload.Number), payload.Timestamp,
+			ctx.BlockHeader().AppHash,
+		))
+	}
+	if err != nil {
 		ctx.Logger().Error("failed to build evm block", "err", err)
 		return &abci.ResponseProcessProposal{
 			Status: abci.ResponseProcessProposal_REJECT,
@@ -96,3 +112,59 @@ func (wbc *WrappedBlockchain) ProcessProposal(
 		Status: abci.ResponseProcessProposal_ACCEPT,
 	}, nil
 }
+
+// verifyPayload verifies that the values of the payload derived from consensus data are the ones
+// the proposer must have set when building it.
+func (wbc *WrappedBlockchain) verifyPayload(
+	ctx sdk.Context, payload *engine.ExecutableData,
+) error {
+	if err := wbc.verifyPrevRandao(ctx, payload); err != nil {
+		return err
+	}
+	return wbc.verifyFeeRecipient(ctx, payload)
+}
+
+// verifyFeeRecipient verifies that the fee recipient of the payload is the operator of the
+// validator proposing the block, as set by the miner when building it. If the proposer cannot be
+// resolved, the miner of the proposer fell back to its own etherbase, which cannot be verified.
+func (wbc *WrappedBlockchain) verifyFeeRecipient(
+	ctx sdk.Context, payload *engine.ExecutableData,
+) error {
+	if wbc.validators == nil {
+		return nil
+	}
+
+	expected, err := evmtypes.ProposerOperator(ctx, wbc.validators)
+	if err != nil {
+		ctx.Logger().Error("failed to resolve the block proposer operator", "err", err)
+		return nil
+	}
+	if payload.FeeRecipient != expected {
+		return fmt.Errorf("invalid fee recipient: have %s, want %s", payload.FeeRecipient, expected)
+	}
+	return nil
+}
+
+// verifyPrevRandao verifies that the random value of the payload is the one derived from the
+// consensus data of the proposal, as done by the miner when building it, or zero if the
+// derivation is not active yet.
+func (wbc *WrappedBlockchain) verifyPrevRandao(
+	ctx sdk.Context, payload *engine.ExecutableData,
+) error {
+	var expected common.Hash
+	if wbc.randao.IsPrevRandao(ctx, payload.Timestamp) {
+		parent := wbc.Blockchain.GetHeaderByHash(payload.ParentHash)
+		if parent == nil {
+			return fmt.Errorf("unknown parent block %s", payload.ParentHash)
+		}
+
+		header := ctx.BlockHeader()
+		expected = evmtypes.PrevRandao(
+			parent.MixDigest, header.AppHash, header.ProposerAddress, ctx.VoteInfos(),
+		)
+	}
+	if payload.Random != expected {
+		return fmt.Errorf("invalid prevRandao: have %s, want %s", payload.Random, expected)
+	}
+	return nil
+}
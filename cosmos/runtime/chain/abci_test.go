@@ -0,0 +1,233 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package chain
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+
+	"github.com/berachain/polaris/cosmos/runtime/txpool/mocks"
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
+	"github.com/berachain/polaris/eth/core"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestChain(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/runtime/chain")
+}
+
+// parentChain is a core.Blockchain that only knows the parent of the proposed blocks, and
+// records the blocks inserted into it.
+type parentChain struct {
+	core.Blockchain
+	parent   *ethtypes.Header
+	inserted []*ethtypes.Block
+}
+
+func (bc *parentChain) Config() *params.ChainConfig {
+	return params.TestChainConfig
+}
+
+func (bc *parentChain) GetHeaderByHash(hash common.Hash) *ethtypes.Header {
+	if hash != bc.parent.Hash() {
+		return nil
+	}
+	return bc.parent
+}
+
+func (bc *parentChain) InsertBlock(block *ethtypes.Block) ([]*ethtypes.Receipt, error) {
+	bc.inserted = append(bc.inserted, block)
+	return nil, nil
+}
+
+// envelopeDecoder decodes every tx into an sdk tx that wraps the given envelope.
+type envelopeDecoder struct {
+	envelope *engine.ExecutionPayloadEnvelope
+}
+
+func (d *envelopeDecoder) TxDecode([]byte) (sdk.Tx, error) {
+	wrapped, err := evmtypes.WrapPayload(d.envelope)
+	if err != nil {
+		return nil, err
+	}
+	sdkTx := mocks.NewSdkTx(GinkgoT())
+	sdkTx.On("GetMsgs").Return([]sdk.Msg{wrapped})
+	return sdkTx, nil
+}
+
+// validatorStore is a ValidatorStore over the validators with the given consensus addresses.
+type validatorStore map[string]stakingtypes.ValidatorI
+
+func (vs validatorStore) ValidatorByConsAddr(
+	_ context.Context, consAddr sdk.ConsAddress,
+) (stakingtypes.ValidatorI, error) {
+	return vs[consAddr.String()], nil
+}
+
+// prevRandaoSchedule is a PrevRandaoSchedule that activates the random value at the given time.
+type prevRandaoSchedule struct {
+	time *uint64
+}
+
+func (s *prevRandaoSchedule) IsPrevRandao(_ sdk.Context, time uint64) bool {
+	return s.time != nil && *s.time <= time
+}
+
+var _ = Describe("ProcessProposal", func() {
+	var (
+		proposer = sdk.ConsAddress(common.HexToAddress("0xc0").Bytes())
+		operator = common.HexToAddress("0x0b")
+		appHash  = common.HexToHash("0xa9").Bytes()
+		bc       *parentChain
+		decoder  *envelopeDecoder
+		vs       validatorStore
+		randao   *prevRandaoSchedule
+		wbc      *WrappedBlockchain
+		ctx      sdk.Context
+	)
+
+	// propose builds the header of a block on top of the parent as the miner of the proposer
+	// does, which is modified before being proposed.
+	propose := func(modify func(*ethtypes.Header)) *abci.ResponseProcessProposal {
+		header := &ethtypes.Header{
+			ParentHash:  bc.parent.Hash(),
+			UncleHash:   ethtypes.EmptyUncleHash,
+			Coinbase:    operator,
+			Root:        common.HexToHash("0x5a"),
+			TxHash:      ethtypes.EmptyTxsHash,
+			ReceiptHash: ethtypes.EmptyReceiptsHash,
+			Difficulty:  new(big.Int),
+			Number:      big.NewInt(2),
+			GasLimit:    30_000_000,
+			Time:        bc.parent.Time + 1,
+			MixDigest: evmtypes.PrevRandao(
+				bc.parent.MixDigest, appHash, proposer, ctx.VoteInfos(),
+			),
+			BaseFee: big.NewInt(params.InitialBaseFee),
+		}
+		modify(header)
+		decoder.envelope = engine.BlockToExecutableData(
+			ethtypes.NewBlockWithHeader(header), new(big.Int), nil,
+		)
+
+		res, _ := wbc.ProcessProposal(ctx, &abci.RequestProcessProposal{Txs: [][]byte{{}}})
+		return res
+	}
+
+	BeforeEach(func() {
+		bc = &parentChain{parent: &ethtypes.Header{
+			Number: big.NewInt(1), Time: 10, MixDigest: common.HexToHash("0x4a"),
+		}}
+		decoder = &envelopeDecoder{}
+		vs = validatorStore{
+			proposer.String(): stakingtypes.Validator{
+				OperatorAddress: sdk.ValAddress(operator.Bytes()).String(),
+			},
+		}
+		randao = &prevRandaoSchedule{time: new(uint64)}
+		wbc = New(bc, decoder, vs, randao, nil)
+		ctx = sdk.Context{}.WithLogger(log.NewNopLogger()).WithBlockHeader(cmtproto.Header{
+			AppHash: appHash, ProposerAddress: proposer,
+		})
+	})
+
+	It("should accept a payload that pays the operator of the proposer", func() {
+		Expect(propose(func(*ethtypes.Header) {}).Status).
+			To(Equal(abci.ResponseProcessProposal_ACCEPT))
+		Expect(bc.inserted).To(HaveLen(1))
+		Expect(bc.inserted[0].Coinbase()).To(Equal(operator))
+	})
+
+	It("should reject a payload that pays another fee recipient", func() {
+		Expect(propose(func(header *ethtypes.Header) {
+			header.Coinbase = common.HexToAddress("0xe7e7")
+		}).Status).To(Equal(abci.ResponseProcessProposal_REJECT))
+		Expect(bc.inserted).To(BeEmpty())
+	})
+
+	It("should accept any fee recipient if the proposer cannot be resolved", func() {
+		delete(vs, proposer.String())
+		Expect(propose(func(header *ethtypes.Header) {
+			header.Coinbase = common.HexToAddress("0xe7e7")
+		}).Status).To(Equal(abci.ResponseProcessProposal_ACCEPT))
+
+		wbc = New(bc, decoder, nil, randao, nil)
+		Expect(propose(func(header *ethtypes.Header) {
+			header.Coinbase = common.HexToAddress("0xe7e7")
+		}).Status).To(Equal(abci.ResponseProcessProposal_ACCEPT))
+	})
+
+	It("should reject a payload with another random value", func() {
+		Expect(propose(func(header *ethtypes.Header) {
+			header.MixDigest = common.HexToHash("0x4b")
+		}).Status).To(Equal(abci.ResponseProcessProposal_REJECT))
+
+		// The random value is derived from the consensus data of the proposal.
+		ctx = ctx.WithBlockHeader(cmtproto.Header{
+			AppHash: common.HexToHash("0xa8").Bytes(), ProposerAddress: proposer,
+		})
+		expected := evmtypes.PrevRandao(bc.parent.MixDigest, appHash, proposer, nil)
+		Expect(propose(func(header *ethtypes.Header) {
+			header.MixDigest = expected
+		}).Status).To(Equal(abci.ResponseProcessProposal_REJECT))
+		Expect(bc.inserted).To(BeEmpty())
+	})
+
+	It("should require a zero random value before the activation", func() {
+		activation := bc.parent.Time + 2
+		randao.time = &activation
+		Expect(propose(func(*ethtypes.Header) {}).Status).
+			To(Equal(abci.ResponseProcessProposal_REJECT))
+		Expect(propose(func(header *ethtypes.Header) {
+			header.MixDigest = common.Hash{}
+		}).Status).To(Equal(abci.ResponseProcessProposal_ACCEPT))
+
+		// The random value is derived from the time of the activation on.
+		Expect(propose(func(header *ethtypes.Header) {
+			header.Time = activation
+			header.MixDigest = common.Hash{}
+		}).Status).To(Equal(abci.ResponseProcessProposal_REJECT))
+		Expect(propose(func(header *ethtypes.Header) {
+			header.Time = activation
+		}).Status).To(Equal(abci.ResponseProcessProposal_ACCEPT))
+		Expect(bc.inserted).To(HaveLen(2))
+	})
+})
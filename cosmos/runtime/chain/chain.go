@@ -24,6 +24,7 @@
 package chain
 
 import (
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
@@ -35,13 +36,22 @@ type PostBlockHookFn func(ethtypes.Transactions, ethtypes.Receipts, ethtypes.Sig
 type WrappedBlockchain struct {
 	core.Blockchain           // chain is the core blockchain.
 	app             txDecoder // App is the application context.
-	hook            PostBlockHookFn
+	// validators resolves the proposer of a block, whose operator must be its fee recipient.
+	validators evmtypes.ValidatorStore
+	// randao reports whether the random value of a block is derived from consensus data.
+	randao evmtypes.PrevRandaoSchedule
+	hook   PostBlockHookFn
 }
 
 // New creates a new instance of WrappedBlockchain with the provided core blockchain
-// and application context.
-func New(chain core.Blockchain, app txDecoder, hook PostBlockHookFn) *WrappedBlockchain {
-	return &WrappedBlockchain{Blockchain: chain, app: app, hook: hook}
+// and application context. The fee recipient of proposals is not verified if validators is nil.
+func New(
+	chain core.Blockchain, app txDecoder, validators evmtypes.ValidatorStore,
+	randao evmtypes.PrevRandaoSchedule, hook PostBlockHookFn,
+) *WrappedBlockchain {
+	return &WrappedBlockchain{
+		Blockchain: chain, app: app, validators: validators, randao: randao, hook: hook,
+	}
 }
 
 func (wbc *WrappedBlockchain) SetBlockchain(chain core.Blockchain) {
@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package comet
+
+import (
+	"strconv"
+
+	"github.com/berachain/polaris/eth/polar"
+	polarapi "github.com/berachain/polaris/eth/polar/api"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// The statuses of governance proposals streamed to subscribers.
+const (
+	ProposalStatusDepositPeriod = "deposit_period"
+	ProposalStatusVotingPeriod  = "voting_period"
+	ProposalStatusPassed        = "passed"
+	ProposalStatusRejected      = "rejected"
+	ProposalStatusFailed        = "failed"
+	ProposalStatusDropped       = "dropped"
+	ProposalStatusCanceled      = "canceled"
+)
+
+var _ polar.SubscriptionProvider = (*EventFeed)(nil)
+
+// EventFeed streams the results of the blocks finalized by CometBFT to subscribers.
+type EventFeed struct {
+	cosmosEvents        event.Feed
+	validatorSetChanges event.Feed
+	proposalStatus      event.Feed
+}
+
+// NewEventFeed returns a new EventFeed.
+func NewEventFeed() *EventFeed {
+	return &EventFeed{}
+}
+
+// SubscribeCosmosEvents subscribes to the Cosmos events emitted in each block.
+func (f *EventFeed) SubscribeCosmosEvents(
+	ch chan<- *polarapi.CosmosBlockEvents,
+) event.Subscription {
+	return f.cosmosEvents.Subscribe(ch)
+}
+
+// SubscribeValidatorSetChanges subscribes to the changes of the validator set.
+func (f *EventFeed) SubscribeValidatorSetChanges(
+	ch chan<- *polarapi.RPCValidatorSetChange,
+) event.Subscription {
+	return f.validatorSetChanges.Subscribe(ch)
+}
+
+// SubscribeProposalStatus subscribes to the status changes of governance proposals.
+func (f *EventFeed) SubscribeProposalStatus(
+	ch chan<- *polarapi.RPCProposalStatus,
+) event.Subscription {
+	return f.proposalStatus.Subscribe(ch)
+}
+
+// FinalizeBlock sends the results of a finalized block to the subscribers. It must be called
+// with the response of every FinalizeBlock request that succeeds.
+func (f *EventFeed) FinalizeBlock(
+	req *abci.RequestFinalizeBlock, res *abci.ResponseFinalizeBlock,
+) {
+	height := hexutil.Uint64(req.Height)
+
+	// Collect the events emitted outside of transactions, then those of each transaction.
+	events := make([]*polarapi.RPCCosmosEvent, 0, len(res.Events))
+	for _, ev := range res.Events {
+		events = append(events, newRPCCosmosEvent(height, nil, ev))
+	}
+	for i, txResult := range res.TxResults {
+		txIndex := hexutil.Uint64(i)
+		for _, ev := range txResult.Events {
+			events = append(events, newRPCCosmosEvent(height, &txIndex, ev))
+		}
+	}
+
+	if len(events) > 0 {
+		f.cosmosEvents.Send(&polarapi.CosmosBlockEvents{Height: uint64(height), Events: events})
+	}
+	if len(res.ValidatorUpdates) > 0 {
+		f.validatorSetChanges.Send(&polarapi.RPCValidatorSetChange{
+			Height:  height,
+			Updates: newRPCValidatorUpdates(res.ValidatorUpdates),
+		})
+	}
+	for _, ev := range events {
+		if status := proposalStatus(ev); status != nil {
+			f.proposalStatus.Send(status)
+		}
+	}
+}
+
+// newRPCCosmosEvent converts an ABCI event into its RPC representation.
+func newRPCCosmosEvent(
+	height hexutil.Uint64, txIndex *hexutil.Uint64, ev abci.Event,
+) *polarapi.RPCCosmosEvent {
+	attributes := make([]polarapi.CosmosEventAttribute, len(ev.Attributes))
+	for i, attr := range ev.Attributes {
+		attributes[i] = polarapi.CosmosEventAttribute{Key: attr.Key, Value: attr.Value}
+	}
+	return &polarapi.RPCCosmosEvent{
+		Height:      height,
+		TxIndex:     txIndex,
+		CosmosEvent: polarapi.CosmosEvent{Type: ev.Type, Attributes: attributes},
+	}
+}
+
+// newRPCValidatorUpdates converts ABCI validator updates into their RPC representation.
+// Updates with a public key that cannot be decoded are skipped.
+func newRPCValidatorUpdates(updates []abci.ValidatorUpdate) []*polarapi.RPCValidatorUpdate {
+	result := make([]*polarapi.RPCValidatorUpdate, 0, len(updates))
+	for _, update := range updates {
+		pubKey, err := cryptoenc.PubKeyFromProto(update.PubKey)
+		if err != nil || update.Power < 0 {
+			continue
+		}
+		result = append(result, &polarapi.RPCValidatorUpdate{
+			PubKeyType: pubKey.Type(),
+			PubKey:     pubKey.Bytes(),
+			Power:      hexutil.Uint64(update.Power),
+		})
+	}
+	return result
+}
+
+// proposalStatus returns the status of the governance proposal changed by the given event, or
+// nil if the event does not change the status of a proposal.
+func proposalStatus(ev *polarapi.RPCCosmosEvent) *polarapi.RPCProposalStatus {
+	var (
+		id, status string
+		attrs      = make(map[string]string, len(ev.Attributes))
+	)
+	for _, attr := range ev.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+
+	switch ev.Type {
+	case govtypes.EventTypeSubmitProposal, govtypes.EventTypeProposalDeposit:
+		// The voting period start attribute holds the id of the proposal that entered its
+		// voting period, and is emitted in a separate event.
+		if votingStart, ok := attrs[govtypes.AttributeKeyVotingPeriodStart]; ok {
+			id, status = votingStart, ProposalStatusVotingPeriod
+		} else if ev.Type == govtypes.EventTypeSubmitProposal {
+			id, status = attrs[govtypes.AttributeKeyProposalID], ProposalStatusDepositPeriod
+		}
+	case govtypes.EventTypeActiveProposal, govtypes.EventTypeInactiveProposal:
+		id = attrs[govtypes.AttributeKeyProposalID]
+		switch attrs[govtypes.AttributeKeyProposalResult] {
+		case govtypes.AttributeValueProposalPassed:
+			status = ProposalStatusPassed
+		case govtypes.AttributeValueProposalRejected:
+			status = ProposalStatusRejected
+		case govtypes.AttributeValueProposalFailed:
+			status = ProposalStatusFailed
+		case govtypes.AttributeValueProposalDropped:
+			status = ProposalStatusDropped
+		case govtypes.AttributeValueExpeditedProposalRejected:
+			// Expedited proposals that are rejected are converted into regular proposals.
+			status = ProposalStatusVotingPeriod
+		}
+	case govtypes.EventTypeCancelProposal:
+		id, status = attrs[govtypes.AttributeKeyProposalID], ProposalStatusCanceled
+	}
+
+	proposalID, err := strconv.ParseUint(id, 10, 64)
+	if status == "" || err != nil {
+		return nil
+	}
+	return &polarapi.RPCProposalStatus{
+		Height:     ev.Height,
+		ProposalID: hexutil.Uint64(proposalID),
+		Status:     status,
+	}
+}
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package comet
+
+import (
+	"testing"
+
+	polarapi "github.com/berachain/polaris/eth/polar/api"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestComet(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/runtime/comet")
+}
+
+var _ = Describe("EventFeed", func() {
+	var (
+		feed     *EventFeed
+		events   chan *polarapi.CosmosBlockEvents
+		changes  chan *polarapi.RPCValidatorSetChange
+		statuses chan *polarapi.RPCProposalStatus
+	)
+
+	BeforeEach(func() {
+		feed = NewEventFeed()
+		events = make(chan *polarapi.CosmosBlockEvents, 1)
+		changes = make(chan *polarapi.RPCValidatorSetChange, 1)
+		statuses = make(chan *polarapi.RPCProposalStatus, 8)
+		DeferCleanup(feed.SubscribeCosmosEvents(events).Unsubscribe)
+		DeferCleanup(feed.SubscribeValidatorSetChanges(changes).Unsubscribe)
+		DeferCleanup(feed.SubscribeProposalStatus(statuses).Unsubscribe)
+	})
+
+	It("should stream the events of a finalized block", func() {
+		feed.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 5}, &abci.ResponseFinalizeBlock{
+			Events: []abci.Event{{Type: "mint"}},
+			TxResults: []*abci.ExecTxResult{{Events: []abci.Event{{
+				Type:       "transfer",
+				Attributes: []abci.EventAttribute{{Key: "amount", Value: "1abera"}},
+			}}}},
+		})
+
+		var block *polarapi.CosmosBlockEvents
+		Eventually(events).Should(Receive(&block))
+		Expect(block.Height).To(Equal(uint64(5)))
+		Expect(block.Events).To(HaveLen(2))
+		Expect(block.Events[0].Type).To(Equal("mint"))
+		Expect(block.Events[0].TxIndex).To(BeNil())
+		Expect(block.Events[1].Type).To(Equal("transfer"))
+		Expect(uint64(*block.Events[1].TxIndex)).To(Equal(uint64(0)))
+		Expect(block.Events[1].Attributes).To(ConsistOf(
+			polarapi.CosmosEventAttribute{Key: "amount", Value: "1abera"},
+		))
+		Consistently(changes).ShouldNot(Receive())
+	})
+
+	It("should stream validator set changes", func() {
+		pubKey, err := cryptoenc.PubKeyToProto(ed25519.GenPrivKey().PubKey())
+		Expect(err).ToNot(HaveOccurred())
+		feed.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 7}, &abci.ResponseFinalizeBlock{
+			ValidatorUpdates: []abci.ValidatorUpdate{{PubKey: pubKey, Power: 10}},
+		})
+
+		var change *polarapi.RPCValidatorSetChange
+		Eventually(changes).Should(Receive(&change))
+		Expect(uint64(change.Height)).To(Equal(uint64(7)))
+		Expect(change.Updates).To(HaveLen(1))
+		Expect(change.Updates[0].PubKeyType).To(Equal(ed25519.KeyType))
+		Expect(uint64(change.Updates[0].Power)).To(Equal(uint64(10)))
+		Consistently(events).ShouldNot(Receive())
+	})
+
+	It("should stream the status changes of governance proposals", func() {
+		feed.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 9}, &abci.ResponseFinalizeBlock{
+			Events: []abci.Event{{
+				Type: "active_proposal",
+				Attributes: []abci.EventAttribute{
+					{Key: "proposal_id", Value: "1"},
+					{Key: "proposal_result", Value: "proposal_passed"},
+				},
+			}},
+			TxResults: []*abci.ExecTxResult{{Events: []abci.Event{
+				{Type: "submit_proposal", Attributes: []abci.EventAttribute{
+					{Key: "proposal_id", Value: "2"},
+				}},
+				{Type: "proposal_deposit", Attributes: []abci.EventAttribute{
+					{Key: "proposal_id", Value: "2"},
+				}},
+				{Type: "submit_proposal", Attributes: []abci.EventAttribute{
+					{Key: "voting_period_start", Value: "2"},
+				}},
+			}}},
+		})
+
+		for _, want := range []struct {
+			id     uint64
+			status string
+		}{
+			{1, ProposalStatusPassed},
+			{2, ProposalStatusDepositPeriod},
+			{2, ProposalStatusVotingPeriod},
+		} {
+			var status *polarapi.RPCProposalStatus
+			Eventually(statuses).Should(Receive(&status))
+			Expect(uint64(status.Height)).To(Equal(uint64(9)))
+			Expect(uint64(status.ProposalID)).To(Equal(want.id))
+			Expect(status.Status).To(Equal(want.status))
+		}
+		Consistently(statuses).ShouldNot(Receive())
+	})
+})
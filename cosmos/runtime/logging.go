@@ -25,54 +25,88 @@ package runtime
 
 import (
 	"context"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
 
+	"github.com/rs/zerolog"
 	"golang.org/x/exp/slog"
 
 	"cosmossdk.io/log"
+
+	"github.com/berachain/polaris/cosmos/config"
+
+	ethlog "github.com/ethereum/go-ethereum/log"
 )
 
+// gethModule is the module of the geth logs, which is suffixed with the geth subsystem that
+// emitted them, e.g. "polaris-geth/txpool".
+const gethModule = "polaris-geth"
+
+// gethPackagePrefixes are the prefixes of the packages that log through the geth logger, which are
+// trimmed to find the subsystem that emitted a log.
+var gethPackagePrefixes = []string{
+	"github.com/ethereum/go-ethereum/", "github.com/berachain/polaris/eth/",
+}
+
 // ethHandler implements the slog.Handler interface.
 // It is used to handle logging for the Ethereum module.
 var _ slog.Handler = (*ethHandler)(nil)
 
-// ethHandler is a struct that contains a logger.
+// ethHandler is a struct that contains a logger, the minimum levels of the records it handles per
+// geth subsystem, and a cache of the subsystems of the call sites of the records.
 type ethHandler struct {
-	logger log.Logger
+	logger     log.Logger
+	levels     *atomic.Pointer[config.GethLogLevels]
+	subsystems *sync.Map
 }
 
 // newEthHandler is a constructor function for ethHandler.
-// It takes a logger as an argument and returns a slog.Handler.
-func newEthHandler(_logger log.Logger) slog.Handler {
-	return &ethHandler{logger: _logger}
+// It takes a logger and the levels, which can be changed while the node is running, as arguments
+// and returns a slog.Handler.
+func newEthHandler(
+	_logger log.Logger, levels *atomic.Pointer[config.GethLogLevels],
+) slog.Handler {
+	return &ethHandler{logger: _logger, levels: levels, subsystems: new(sync.Map)}
 }
 
 // With is a method on ethHandler that returns a new ethHandler with
 // additional context.
 func (h *ethHandler) With(ctx ...interface{}) slog.Handler {
-	return &ethHandler{logger: h.logger.With(ctx...)}
+	return &ethHandler{logger: h.logger.With(ctx...), levels: h.levels, subsystems: h.subsystems}
 }
 
 // Handle is a method on ethHandler that logs a message at the
 // appropriate level with context key/value pairs.
 func (h *ethHandler) Handle(_ context.Context, r slog.Record) error {
-	polarisGethHandler := h.logger.With("module", "polaris-geth")
+	subsystem := h.subsystem(r.PC)
+	if r.Level < h.levels.Load().Level(subsystem) {
+		return nil
+	}
+	polarisGethHandler := h.logger.With("module", gethModule+"/"+subsystem)
 	x := r.NumAttrs()
-	attrs := make([]interface{}, 0, x*2) //nolint:gomnd // 2 times.
+	attrs := make([]interface{}, 0, x*2+2) //nolint:gomnd // 2 times, and the geth level.
 	r.Attrs(func(a slog.Attr) bool {
 		attrs = append(attrs, a.Key)
 		attrs = append(attrs, a.Value)
 		x--
 		return x != -1
 	})
-	switch r.Level { //nolint:nolintlint,exhaustive // linter is bugged.
-	case slog.LevelDebug:
-		polarisGethHandler.Debug(r.Message, attrs...)
-	case slog.LevelInfo:
-		polarisGethHandler.Info(r.Message, attrs...)
-	case slog.LevelWarn:
-		polarisGethHandler.Error(r.Message, attrs...)
-	case slog.LevelError:
+	// The Cosmos logger has no trace or crit levels, so keep them distinguishable from debug and
+	// error logs.
+	if r.Level < ethlog.LevelDebug || r.Level > ethlog.LevelError {
+		attrs = append(attrs, "geth_level", ethlog.LevelString(r.Level))
+	}
+	switch {
+	case r.Level >= ethlog.LevelError:
 		polarisGethHandler.Error(r.Message, attrs...)
+	case r.Level >= ethlog.LevelWarn:
+		polarisGethHandler.Warn(r.Message, attrs...)
+	case r.Level >= ethlog.LevelInfo:
+		polarisGethHandler.Info(r.Message, attrs...)
+	default:
+		polarisGethHandler.Debug(r.Message, attrs...)
 	}
 	return nil
 }
@@ -84,7 +118,7 @@ func (h *ethHandler) WithAttrs(as []slog.Attr) slog.Handler {
 	for _, a := range as {
 		newLogger = newLogger.With(a.Key, a.Value)
 	}
-	return &ethHandler{logger: newLogger}
+	return &ethHandler{logger: newLogger, levels: h.levels, subsystems: h.subsystems}
 }
 
 // WithGroup is a method on ethHandler that returns a new ethHandler with
@@ -93,7 +127,65 @@ func (h *ethHandler) WithGroup(name string) slog.Handler {
 	return h.WithAttrs([]slog.Attr{{Key: "group", Value: slog.StringValue(name)}})
 }
 
-// Enabled reports whether l emits log records at the given context and level.
-func (*ethHandler) Enabled(_ context.Context, _ slog.Level) bool {
+// Enabled reports whether l emits log records at the given context and level, which requires
+// both the level of some geth subsystem and the level of the Cosmos logger to allow it, so that
+// filtered geth logs are not computed.
+func (h *ethHandler) Enabled(_ context.Context, level slog.Level) bool {
+	if level < h.levels.Load().MinLevel() {
+		return false
+	}
+	if zl, ok := h.logger.Impl().(*zerolog.Logger); ok {
+		return cosmosLevel(level) >= max(zl.GetLevel(), zerolog.GlobalLevel())
+	}
 	return true
 }
+
+// subsystem returns the geth subsystem of the given call site, e.g. "txpool" for the legacy
+// txpool, caching it per call site.
+func (h *ethHandler) subsystem(pc uintptr) string {
+	if subsystem, ok := h.subsystems.Load(pc); ok {
+		return subsystem.(string) //nolint:errcheck // only strings are stored.
+	}
+	subsystem := gethSubsystem(pc)
+	h.subsystems.Store(pc, subsystem)
+	return subsystem
+}
+
+// gethSubsystem returns the geth subsystem of the package of the given call site, which is the
+// first element of its path below core and eth, e.g. "txpool" for core/txpool/legacypool.
+func gethSubsystem(pc uintptr) string {
+	frame, _ := runtime.CallersFrames([]uintptr{pc}).Next()
+	// The function is qualified by its package path, e.g. ".../core/txpool/legacypool.(*T).f".
+	pkg := frame.Function
+	if i := strings.LastIndex(pkg, "/"); i >= 0 {
+		if j := strings.Index(pkg[i:], "."); j >= 0 {
+			pkg = pkg[:i+j]
+		}
+	}
+	for _, prefix := range gethPackagePrefixes {
+		if !strings.HasPrefix(pkg, prefix) {
+			continue
+		}
+		elems := strings.Split(strings.TrimPrefix(pkg, prefix), "/")
+		if len(elems) > 1 && (elems[0] == "core" || elems[0] == "eth") {
+			return elems[1]
+		}
+		return elems[0]
+	}
+	return "geth"
+}
+
+// cosmosLevel returns the level of the Cosmos logger that geth logs of the given level are
+// emitted at.
+func cosmosLevel(level slog.Level) zerolog.Level {
+	switch {
+	case level >= ethlog.LevelError:
+		return zerolog.ErrorLevel
+	case level >= ethlog.LevelWarn:
+		return zerolog.WarnLevel
+	case level >= ethlog.LevelInfo:
+		return zerolog.InfoLevel
+	default:
+		return zerolog.DebugLevel
+	}
+}
@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang.org/x/exp/slog"
+
+	cosmoslog "cosmossdk.io/log"
+
+	"github.com/berachain/polaris/cosmos/config"
+	pcore "github.com/berachain/polaris/eth/core"
+	"github.com/berachain/polaris/eth/polar"
+
+	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	ethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/miner"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// pcOf returns a pc within the given function. Call sites are return addresses, which the frames
+// of a pc step back from, so the entry of the function itself is skipped.
+func pcOf(fn any) uintptr {
+	return reflect.ValueOf(fn).Pointer() + 1
+}
+
+var _ = Describe("ethHandler", func() {
+	var (
+		logs    *bytes.Buffer
+		levels  *atomic.Pointer[config.GethLogLevels]
+		handler slog.Handler
+	)
+
+	// setLevels sets the geth log levels of the handler.
+	setLevels := func(s string) {
+		parsed, err := config.ParseGethLogLevels(s)
+		Expect(err).ToNot(HaveOccurred())
+		levels.Store(&parsed)
+	}
+
+	// handle handles a record of the given level from the given function.
+	handle := func(level slog.Level, fn any, msg string, attrs ...slog.Attr) {
+		r := slog.NewRecord(time.Now(), level, msg, pcOf(fn))
+		r.AddAttrs(attrs...)
+		Expect(handler.Handle(context.Background(), r)).To(Succeed())
+	}
+
+	// entries returns the logged entries.
+	entries := func() []map[string]any {
+		var parsed []map[string]any
+		for _, line := range strings.Split(strings.TrimSpace(logs.String()), "\n") {
+			if line == "" {
+				continue
+			}
+			var entry map[string]any
+			Expect(json.Unmarshal([]byte(line), &entry)).To(Succeed())
+			parsed = append(parsed, entry)
+		}
+		return parsed
+	}
+
+	BeforeEach(func() {
+		logs = &bytes.Buffer{}
+		levels = &atomic.Pointer[config.GethLogLevels]{}
+		setLevels("")
+		handler = newEthHandler(cosmoslog.NewLogger(
+			logs, cosmoslog.OutputJSONOption(), cosmoslog.LevelOption(zerolog.DebugLevel),
+		), levels)
+	})
+
+	It("should find the geth subsystem of a call site", func() {
+		Expect(gethSubsystem(pcOf(legacypool.New))).To(Equal("txpool"))
+		Expect(gethSubsystem(pcOf(filters.NewFilterSystem))).To(Equal("filters"))
+		Expect(gethSubsystem(pcOf(miner.New))).To(Equal("miner"))
+		Expect(gethSubsystem(pcOf(polar.New))).To(Equal("polar"))
+		Expect(gethSubsystem(pcOf(pcore.NewChain))).To(Equal("core"))
+		Expect(gethSubsystem(pcOf(strings.Split))).To(Equal("geth"))
+	})
+
+	It("should log under the module of the geth subsystem", func() {
+		handle(ethlog.LevelInfo, legacypool.New, "hello", slog.String("hash", "0x01"))
+		Expect(entries()).To(ConsistOf(SatisfyAll(
+			HaveKeyWithValue("module", "polaris-geth/txpool"),
+			HaveKeyWithValue("message", "hello"),
+			HaveKeyWithValue("level", "info"),
+			HaveKeyWithValue("hash", "0x01"),
+			Not(HaveKey("geth_level")),
+		)))
+	})
+
+	It("should keep the trace and crit levels as the geth level", func() {
+		handle(ethlog.LevelTrace, miner.New, "trace")
+		handle(ethlog.LevelDebug, miner.New, "debug")
+		handle(ethlog.LevelError, miner.New, "error")
+		handle(ethlog.LevelCrit, miner.New, "crit")
+		Expect(entries()).To(ConsistOf(
+			SatisfyAll(
+				HaveKeyWithValue("message", "trace"),
+				HaveKeyWithValue("level", "debug"),
+				HaveKeyWithValue("geth_level", "trace"),
+			),
+			SatisfyAll(
+				HaveKeyWithValue("message", "debug"),
+				HaveKeyWithValue("level", "debug"),
+				Not(HaveKey("geth_level")),
+			),
+			SatisfyAll(
+				HaveKeyWithValue("message", "error"),
+				HaveKeyWithValue("level", "error"),
+				Not(HaveKey("geth_level")),
+			),
+			SatisfyAll(
+				HaveKeyWithValue("message", "crit"),
+				HaveKeyWithValue("level", "error"),
+				HaveKeyWithValue("geth_level", "crit"),
+			),
+		))
+	})
+
+	It("should filter the records per geth subsystem", func() {
+		setLevels("txpool:warn,*:info")
+		handle(ethlog.LevelInfo, legacypool.New, "txpool info")
+		handle(ethlog.LevelWarn, legacypool.New, "txpool warn")
+		handle(ethlog.LevelDebug, miner.New, "miner debug")
+		handle(ethlog.LevelInfo, miner.New, "miner info")
+
+		var messages []any
+		for _, entry := range entries() {
+			messages = append(messages, entry["message"])
+		}
+		Expect(messages).To(ConsistOf("txpool warn", "miner info"))
+
+		// The levels can be changed while the node is running.
+		setLevels("txpool:debug,*:info")
+		handle(ethlog.LevelInfo, legacypool.New, "txpool info")
+		Expect(entries()).To(ContainElement(HaveKeyWithValue("message", "txpool info")))
+	})
+
+	It("should only be enabled at the levels of both geth and the Cosmos logger", func() {
+		ctx := context.Background()
+		// The Cosmos logger is at the debug level, which geth trace logs are emitted at.
+		Expect(handler.Enabled(ctx, ethlog.LevelTrace)).To(BeTrue())
+		Expect(handler.Enabled(ctx, ethlog.LevelDebug)).To(BeTrue())
+
+		setLevels("txpool:debug,*:warn")
+		Expect(handler.Enabled(ctx, ethlog.LevelTrace)).To(BeFalse())
+		Expect(handler.Enabled(ctx, ethlog.LevelDebug)).To(BeTrue())
+
+		handler = newEthHandler(cosmoslog.NewLogger(
+			logs, cosmoslog.OutputJSONOption(), cosmoslog.LevelOption(zerolog.WarnLevel),
+		), levels)
+		setLevels("")
+		Expect(handler.Enabled(ctx, ethlog.LevelInfo)).To(BeFalse())
+		Expect(handler.Enabled(ctx, ethlog.LevelWarn)).To(BeTrue())
+		Expect(handler.Enabled(ctx, ethlog.LevelCrit)).To(BeTrue())
+	})
+})
@@ -24,6 +24,7 @@
 package miner
 
 import (
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -42,6 +43,10 @@ type (
 		TxDecode(txBytes []byte) (sdk.Tx, error)
 	}
 
+	// ValidatorStore maps the consensus address of a validator to the validator, and is used to
+	// pay the priority fees of a block to the validator that proposed it.
+	ValidatorStore = evmtypes.ValidatorStore
+
 	// EVMKeeper is an interface that defines the methods needed for the EVM setup.
 	EVMKeeper interface {
 		// Setup initializes the EVM keeper.
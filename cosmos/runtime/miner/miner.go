@@ -27,11 +27,13 @@ package miner
 import (
 	"context"
 	"errors"
+	"math/big"
 	"sync"
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth"
 	"github.com/berachain/polaris/eth/core"
 
@@ -49,6 +51,10 @@ type Miner struct {
 	miner eth.Miner
 	app   TxDecoder
 	bc    core.Blockchain
+	// validators resolves the proposer of a block, whose operator receives its priority fees.
+	validators ValidatorStore
+	// randao reports whether the random value of a block is derived from consensus data.
+	randao evmtypes.PrevRandaoSchedule
 
 	valTxSelector  baseapp.TxSelector
 	serializer     EnvelopeSerializer
@@ -60,13 +66,16 @@ type Miner struct {
 
 // New produces a cosmos miner from a geth miner.
 func New(
-	miner eth.Miner, app TxDecoder, allowedValMsgs map[string]sdk.Msg,
+	miner eth.Miner, app TxDecoder, validators ValidatorStore,
+	randao evmtypes.PrevRandaoSchedule, allowedValMsgs map[string]sdk.Msg,
 	bc core.Blockchain, blockBuilderMu *sync.RWMutex,
 ) *Miner {
 	return &Miner{
 		miner:          miner,
 		app:            app,
 		bc:             bc,
+		validators:     validators,
+		randao:         randao,
 		allowedValMsgs: allowedValMsgs,
 		valTxSelector:  baseapp.NewDefaultTxSelector(),
 		blockBuilderMu: blockBuilderMu,
@@ -123,7 +132,9 @@ func (m *Miner) submitPayloadForBuilding(ctx context.Context) error {
 	ts := max(uint64(sCtx.BlockTime().Unix()), prevBlockTs+1)
 
 	// Build Payload.
-	if payload, err = m.miner.BuildPayload(m.constructPayloadArgs(ts)); err != nil {
+	if payload, err = m.miner.BuildPayload(
+		m.constructPayloadArgs(sCtx, ts),
+	); err != nil {
 		sCtx.Logger().Error("failed to build payload", "err", err)
 		return err
 	}
@@ -133,14 +144,47 @@ func (m *Miner) submitPayloadForBuilding(ctx context.Context) error {
 }
 
 // constructPayloadArgs builds a payload to submit to the miner.
-func (m *Miner) constructPayloadArgs(blockTime uint64) *miner.BuildPayloadArgs {
+func (m *Miner) constructPayloadArgs(ctx sdk.Context, blockTime uint64) *miner.BuildPayloadArgs {
+	var (
+		header       = ctx.BlockHeader()
+		number       = big.NewInt(1)
+		parentRandao common.Hash
+		random       common.Hash
+	)
+	// The payload is built on top of the current block.
+	if head := m.bc.CurrentBlock(); head != nil {
+		number.Add(number, head.Number)
+		parentRandao = head.MixDigest
+	}
+	if m.randao.IsPrevRandao(ctx, blockTime) {
+		random = evmtypes.PrevRandao(
+			parentRandao, header.AppHash, header.ProposerAddress, ctx.VoteInfos(),
+		)
+	}
+
 	return &miner.BuildPayloadArgs{
 		Timestamp:    blockTime,
-		FeeRecipient: m.miner.Etherbase(),
-		Random:       common.Hash{}, /* todo: generated random */
+		FeeRecipient: m.feeRecipient(ctx),
+		Random:       random,
 		Withdrawals:  make(ethtypes.Withdrawals, 0),
-		BeaconRoot:   nil, // Add this when implementing Cancun.
+		BeaconRoot:   evmtypes.ParentBeaconRoot(m.bc.Config(), number, blockTime, header.AppHash),
+	}
+}
+
+// feeRecipient returns the address that receives the priority fees of the block being built,
+// which is the EVM address of the operator of the validator proposing the block. The configured
+// etherbase is used if the proposer cannot be resolved.
+func (m *Miner) feeRecipient(ctx sdk.Context) common.Address {
+	if m.validators == nil {
+		return m.miner.Etherbase()
+	}
+
+	operator, err := evmtypes.ProposerOperator(ctx, m.validators)
+	if err != nil {
+		ctx.Logger().Error("failed to resolve the block proposer operator", "err", err)
+		return m.miner.Etherbase()
 	}
+	return operator
 }
 
 // resolveEnvelope resolves the payload.
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package miner
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
+	"github.com/berachain/polaris/eth"
+	"github.com/berachain/polaris/eth/core"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestMiner(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/runtime/miner")
+}
+
+// etherbaseMiner is an eth.Miner that only knows its etherbase.
+type etherbaseMiner struct {
+	eth.Miner
+	etherbase common.Address
+}
+
+func (m *etherbaseMiner) Etherbase() common.Address {
+	return m.etherbase
+}
+
+// validatorStore is a ValidatorStore over the validators with the given consensus addresses.
+type validatorStore struct {
+	validators map[string]stakingtypes.ValidatorI
+	err        error
+}
+
+func (vs *validatorStore) ValidatorByConsAddr(
+	_ context.Context, consAddr sdk.ConsAddress,
+) (stakingtypes.ValidatorI, error) {
+	return vs.validators[consAddr.String()], vs.err
+}
+
+// headChain is a core.Blockchain that only knows its current block.
+type headChain struct {
+	core.Blockchain
+	head *ethtypes.Header
+}
+
+func (bc *headChain) CurrentBlock() *ethtypes.Header {
+	return bc.head
+}
+
+func (bc *headChain) Config() *params.ChainConfig {
+	return params.TestChainConfig
+}
+
+// prevRandaoSchedule is a PrevRandaoSchedule that activates the random value at the given time.
+type prevRandaoSchedule uint64
+
+func (s prevRandaoSchedule) IsPrevRandao(_ sdk.Context, time uint64) bool {
+	return uint64(s) <= time
+}
+
+var _ = Describe("constructPayloadArgs", func() {
+	It("should derive the random value from consensus data once activated", func() {
+		var (
+			head     = &ethtypes.Header{Number: big.NewInt(1), MixDigest: common.HexToHash("0x4a")}
+			appHash  = common.HexToHash("0xa9").Bytes()
+			proposer = common.HexToAddress("0xc0").Bytes()
+			ctx      = sdk.Context{}.WithLogger(log.NewNopLogger()).
+					WithBlockHeader(cmtproto.Header{AppHash: appHash, ProposerAddress: proposer})
+			m = New(&etherbaseMiner{}, nil, nil, prevRandaoSchedule(20), nil,
+				&headChain{head: head}, nil)
+		)
+
+		Expect(m.constructPayloadArgs(ctx, 19).Random).To(Equal(common.Hash{}))
+		Expect(m.constructPayloadArgs(ctx, 20).Random).
+			To(Equal(evmtypes.PrevRandao(head.MixDigest, appHash, proposer, nil)))
+	})
+})
+
+var _ = Describe("feeRecipient", func() {
+	var (
+		etherbase = common.HexToAddress("0xe7e7")
+		proposer  = sdk.ConsAddress(common.HexToAddress("0xc0").Bytes())
+		operator  = sdk.ValAddress(common.HexToAddress("0x0b").Bytes())
+		vs        *validatorStore
+		ctx       sdk.Context
+		m         *Miner
+	)
+
+	BeforeEach(func() {
+		vs = &validatorStore{validators: map[string]stakingtypes.ValidatorI{
+			proposer.String(): stakingtypes.Validator{OperatorAddress: operator.String()},
+		}}
+		ctx = sdk.Context{}.WithLogger(log.NewNopLogger()).
+			WithBlockHeader(cmtproto.Header{ProposerAddress: proposer})
+		m = New(&etherbaseMiner{etherbase: etherbase}, nil, vs, nil, nil, nil, nil)
+	})
+
+	It("should pay the operator of the proposer", func() {
+		Expect(m.feeRecipient(ctx)).To(Equal(common.BytesToAddress(operator)))
+	})
+
+	It("should pay the etherbase without a validator store", func() {
+		m = New(&etherbaseMiner{etherbase: etherbase}, nil, nil, nil, nil, nil, nil)
+		Expect(m.feeRecipient(ctx)).To(Equal(etherbase))
+	})
+
+	It("should pay the etherbase if the proposer cannot be resolved", func() {
+		// The proposer is unknown.
+		unknown := sdk.ConsAddress(common.HexToAddress("0xc1").Bytes())
+		Expect(m.feeRecipient(ctx.WithBlockHeader(cmtproto.Header{ProposerAddress: unknown}))).
+			To(Equal(etherbase))
+
+		// The proposer has an invalid operator.
+		vs.validators[proposer.String()] = stakingtypes.Validator{OperatorAddress: "invalid"}
+		Expect(m.feeRecipient(ctx)).To(Equal(etherbase))
+
+		// The validators cannot be read.
+		vs.err = errors.New("store unavailable")
+		Expect(m.feeRecipient(ctx)).To(Equal(etherbase))
+	})
+})
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package runtime
+
+import (
+	"math/big"
+	"os"
+	"os/signal"
+	"slices"
+	"syscall"
+
+	"github.com/berachain/polaris/cosmos/config"
+	"github.com/berachain/polaris/eth"
+	"github.com/berachain/polaris/eth/node"
+	"github.com/berachain/polaris/eth/polar"
+)
+
+// accountSlotsField is the field of the account slots of the legacy txpool in the config.
+const accountSlotsField = "Polar.LegacyTxPool.AccountSlots"
+
+// reloadableFields are the fields of the config that ReloadConfig applies while the node is
+// running. The geth txpool keeps its own copy of the global slot and queue settings, so they
+// only take effect on restart.
+var reloadableFields = map[string]struct{}{
+	"GethLogLevel":                  {},
+	"Polar.RPCGasCap":               {},
+	"Polar.RPCEVMTimeout":           {},
+	"Polar.RPCTxFeeCap":             {},
+	"Polar.LegacyTxPool.PriceLimit": {},
+	"Polar.LegacyTxPool.Lifetime":   {},
+	accountSlotsField:               {},
+}
+
+// ReloadConfig applies the settings of the given config that are safe to change while the node
+// is running, which are the geth log levels, the RPC gas cap, EVM timeout and tx fee cap, and the
+// txpool price limit, lifetime and account slots. Any other change is logged and takes effect on
+// the next restart. It returns the changes that were applied.
+func (p *Polaris) ReloadConfig(cfg *eth.Config) []config.FieldDiff {
+	p.reloadMu.Lock()
+	defer p.reloadMu.Unlock()
+
+	var applied []config.FieldDiff
+	for _, diff := range config.Diff(cfg, p.cfg) {
+		if _, ok := reloadableFields[diff.Field]; !ok {
+			p.logger.Warn("polaris config change requires a restart",
+				"field", diff.Field, "value", diff.Value, "current", diff.Default)
+			continue
+		}
+		applied = append(applied, diff)
+	}
+	if len(applied) == 0 {
+		return nil
+	}
+
+	// The config was validated when it was read, so its geth log levels parse.
+	_ = p.setGethLogLevel(cfg.GethLogLevel)
+	p.cfg.Polar.RPCGasCap = cfg.Polar.RPCGasCap
+	p.cfg.Polar.RPCEVMTimeout = cfg.Polar.RPCEVMTimeout
+	p.cfg.Polar.RPCTxFeeCap = cfg.Polar.RPCTxFeeCap
+	p.cfg.Polar.LegacyTxPool.PriceLimit = cfg.Polar.LegacyTxPool.PriceLimit
+	p.cfg.Polar.LegacyTxPool.Lifetime = cfg.Polar.LegacyTxPool.Lifetime
+
+	// Hold off block building and txpool inserts while the settings are swapped, so that no
+	// transaction is admitted under a mix of the old and new settings.
+	p.blockBuilderMu.Lock()
+	p.rpcLimits.SetRPCLimits(polar.RPCLimits{
+		GasCap:     p.cfg.Polar.RPCGasCap,
+		EVMTimeout: p.cfg.Polar.RPCEVMTimeout,
+		TxFeeCap:   p.cfg.Polar.RPCTxFeeCap,
+	})
+	priceLimit := new(big.Int).SetUint64(p.cfg.Polar.LegacyTxPool.PriceLimit)
+	p.gethTxPool.SetGasTip(priceLimit)
+	p.WrappedTxPool.SetPriceLimit(priceLimit)
+	p.WrappedTxPool.SetLifetime(int64(p.cfg.Polar.LegacyTxPool.Lifetime))
+	if cfg.Polar.LegacyTxPool.AccountSlots != p.cfg.Polar.LegacyTxPool.AccountSlots {
+		if err := p.accountSlots.SetAccountSlots(cfg.Polar.LegacyTxPool.AccountSlots); err != nil {
+			p.logger.Error("failed to apply polaris config change, a restart applies it",
+				"field", accountSlotsField, "err", err)
+			applied = slices.DeleteFunc(applied, func(diff config.FieldDiff) bool {
+				return diff.Field == accountSlotsField
+			})
+		} else {
+			p.cfg.Polar.LegacyTxPool.AccountSlots = cfg.Polar.LegacyTxPool.AccountSlots
+		}
+	}
+	p.blockBuilderMu.Unlock()
+
+	for _, diff := range applied {
+		p.logger.Info("applied polaris config change",
+			"field", diff.Field, "value", diff.Value, "previous", diff.Default)
+	}
+	return applied
+}
+
+// ReloadConfigOnSignal reloads the polaris section of the app.toml in the given home directory
+// whenever the process receives SIGHUP, applying it with ReloadConfig. An invalid config is
+// logged and leaves the current one in place. It must be called before the services are
+// started.
+func (p *Polaris) ReloadConfigOnSignal(home string) {
+	p.RegisterLifecycles([]node.Lifecycle{&configReloader{
+		p:    p,
+		home: home,
+		sigs: make(chan os.Signal, 1),
+		done: make(chan struct{}),
+	}})
+}
+
+// configReloader is a lifecycle that reloads the config whenever the process receives SIGHUP.
+type configReloader struct {
+	p    *Polaris
+	home string
+	sigs chan os.Signal
+	done chan struct{}
+}
+
+// Start starts listening for SIGHUP.
+func (r *configReloader) Start() error {
+	signal.Notify(r.sigs, syscall.SIGHUP)
+	go func() {
+		for {
+			select {
+			case <-r.sigs:
+				r.reload()
+			case <-r.done:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops listening for SIGHUP.
+func (r *configReloader) Stop() error {
+	signal.Stop(r.sigs)
+	close(r.done)
+	return nil
+}
+
+// reload reads the config from app.toml and applies it.
+func (r *configReloader) reload() {
+	r.p.logger.Info("reloading polaris config", "home", r.home)
+	cfg, err := config.ReadConfigFromAppTOML(r.home)
+	if err != nil {
+		r.p.logger.Error("failed to reload polaris config, keeping the current one", "err", err)
+		return
+	}
+	if applied := r.p.ReloadConfig(cfg); len(applied) == 0 {
+		r.p.logger.Info("no polaris config change to apply")
+	}
+}
+
+// setGethLogLevel sets the minimum levels of geth logs per geth subsystem, in the format of
+// config.ParseGethLogLevels. It must be called with reloadMu held, or before the node starts.
+func (p *Polaris) setGethLogLevel(levels string) error {
+	parsed, err := config.ParseGethLogLevels(levels)
+	if err != nil {
+		return err
+	}
+	p.cfg.GethLogLevel = levels
+	p.gethLogLevels.Store(&parsed)
+	return nil
+}
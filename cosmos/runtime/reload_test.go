@@ -0,0 +1,266 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	cosmoslog "cosmossdk.io/log"
+
+	"github.com/berachain/polaris/cosmos/config"
+	"github.com/berachain/polaris/cosmos/runtime/txpool"
+	"github.com/berachain/polaris/cosmos/runtime/txpool/mocks"
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
+	"github.com/berachain/polaris/eth"
+	"github.com/berachain/polaris/eth/core"
+	"github.com/berachain/polaris/eth/core/types"
+	"github.com/berachain/polaris/eth/polar"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestRuntime(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/runtime")
+}
+
+// fakeBackend is the backend and geth txpool of a test runtime, which records the changes made
+// to them.
+type fakeBackend struct {
+	eth.TxPool
+	core.ChainReader
+	limits polar.RPCLimits
+	gasTip *big.Int
+	// accountSlots are the account slots set, which fail to be set with accountSlotsErr.
+	accountSlots    uint64
+	accountSlotsErr error
+	pending         map[common.Address][]*ethtypes.Transaction
+	queued          map[common.Address][]*ethtypes.Transaction
+	removed         []common.Hash
+}
+
+func (b *fakeBackend) SetRPCLimits(limits polar.RPCLimits) { b.limits = limits }
+
+func (b *fakeBackend) SetGasTip(tip *big.Int) { b.gasTip = tip }
+
+func (b *fakeBackend) SetAccountSlots(slots uint64) error {
+	if b.accountSlotsErr != nil {
+		return b.accountSlotsErr
+	}
+	b.accountSlots = slots
+	return nil
+}
+
+func (b *fakeBackend) Content() (
+	map[common.Address][]*ethtypes.Transaction, map[common.Address][]*ethtypes.Transaction,
+) {
+	return b.pending, b.queued
+}
+
+func (b *fakeBackend) ContentFrom(
+	addr common.Address,
+) ([]*ethtypes.Transaction, []*ethtypes.Transaction) {
+	return b.pending[addr], b.queued[addr]
+}
+
+func (b *fakeBackend) Remove(txHash common.Hash) { b.removed = append(b.removed, txHash) }
+
+func (b *fakeBackend) GetTransactionLookup(common.Hash) *types.TxLookupEntry { return nil }
+
+// newTestPolaris returns a runtime with the given config, whose backend is the given fake and
+// whose logs are written to the given buffer as JSON.
+func newTestPolaris(cfg *eth.Config, backend *fakeBackend, logs *bytes.Buffer) *Polaris {
+	current := *cfg
+	p := &Polaris{
+		cfg:          &current,
+		logger:       cosmoslog.NewLogger(logs, cosmoslog.OutputJSONOption()),
+		rpcLimits:    backend,
+		accountSlots: backend,
+		gethTxPool:   backend,
+	}
+	Expect(p.setGethLogLevel(cfg.GethLogLevel)).To(Succeed())
+	p.WrappedTxPool = txpool.New(
+		backend, backend, int64(cfg.Polar.LegacyTxPool.Lifetime), &p.blockBuilderMu,
+		new(big.Int).SetUint64(cfg.Polar.LegacyTxPool.PriceLimit),
+	)
+	return p
+}
+
+// newSdkTx returns an sdk tx that wraps the given eth tx.
+func newSdkTx(ethTx *ethtypes.Transaction) sdk.Tx {
+	wrapped, err := evmtypes.WrapTx(ethTx)
+	Expect(err).ToNot(HaveOccurred())
+	tx := mocks.NewSdkTx(GinkgoT())
+	tx.EXPECT().GetMsgs().Return([]sdk.Msg{wrapped})
+	return tx
+}
+
+// recheckTx returns the reason the mempool of the given runtime ejects the given tx on its
+// recheck at the given time, or nil if it is kept.
+func recheckTx(p *Polaris, ethTx *ethtypes.Transaction, blockTime int64) error {
+	ctx := sdk.Context{}.
+		WithExecMode(sdk.ExecModeReCheck).
+		WithBlockTime(time.Unix(blockTime, 0))
+	_, err := p.WrappedTxPool.AnteHandle(ctx, newSdkTx(ethTx), false,
+		func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
+	return err
+}
+
+// loggedFields returns the fields of the logs with the given message.
+func loggedFields(logs *bytes.Buffer, msg string) []string {
+	var fields []string
+	for _, line := range strings.Split(strings.TrimSpace(logs.String()), "\n") {
+		var entry map[string]any
+		Expect(json.Unmarshal([]byte(line), &entry)).To(Succeed())
+		if entry["message"] == msg {
+			fields = append(fields, entry["field"].(string))
+		}
+	}
+	return fields
+}
+
+var _ = Describe("ReloadConfig", func() {
+	var (
+		p       *Polaris
+		backend *fakeBackend
+		logs    *bytes.Buffer
+		cfg     *eth.Config
+	)
+
+	BeforeEach(func() {
+		backend = &fakeBackend{}
+		logs = &bytes.Buffer{}
+		cfg = config.DefaultPolarisConfig()
+		cfg.Polar.LegacyTxPool.PriceLimit = 1
+		cfg.Polar.LegacyTxPool.Lifetime = 100
+		p = newTestPolaris(cfg, backend, logs)
+	})
+
+	// ejectReason returns the reason the mempool ejects a tx with the given gas price on its
+	// recheck at the given time, or nil if it is kept.
+	ejectReason := func(gasPrice int64, blockTime int64) error {
+		return recheckTx(
+			p, ethtypes.NewTx(&ethtypes.LegacyTx{GasPrice: big.NewInt(gasPrice)}), blockTime,
+		)
+	}
+
+	It("should apply nothing when the config is unchanged", func() {
+		Expect(p.ReloadConfig(cfg)).To(BeEmpty())
+		Expect(backend.gasTip).To(BeNil())
+		Expect(logs.Len()).To(BeZero())
+	})
+
+	It("should apply the reloadable fields", func() {
+		Expect(ejectReason(5, 500)).To(MatchError(txpool.ErrTxExpired))
+
+		reloaded := *cfg
+		reloaded.GethLogLevel = "*:warn,txpool:debug"
+		reloaded.Polar.RPCGasCap = 1234
+		reloaded.Polar.RPCEVMTimeout = 7 * time.Second
+		reloaded.Polar.RPCTxFeeCap = 2.5
+		reloaded.Polar.LegacyTxPool.PriceLimit = 5
+		reloaded.Polar.LegacyTxPool.Lifetime = 1000
+		reloaded.Polar.LegacyTxPool.AccountSlots = 4
+
+		var applied []string
+		for _, diff := range p.ReloadConfig(&reloaded) {
+			applied = append(applied, diff.Field)
+		}
+		Expect(applied).To(ConsistOf(
+			"GethLogLevel", "Polar.RPCGasCap", "Polar.RPCEVMTimeout", "Polar.RPCTxFeeCap",
+			"Polar.LegacyTxPool.PriceLimit", "Polar.LegacyTxPool.Lifetime",
+			"Polar.LegacyTxPool.AccountSlots",
+		))
+		Expect(loggedFields(logs, "applied polaris config change")).To(ConsistOf(applied))
+		Expect(loggedFields(logs, "polaris config change requires a restart")).To(BeEmpty())
+
+		Expect(p.gethLogLevels.Load().String()).To(Equal("*:warn,txpool:debug"))
+		Expect(backend.limits).To(Equal(polar.RPCLimits{
+			GasCap: 1234, EVMTimeout: 7 * time.Second, TxFeeCap: 2.5,
+		}))
+		Expect(backend.gasTip).To(Equal(big.NewInt(5)))
+		Expect(backend.accountSlots).To(Equal(uint64(4)))
+
+		// The mempool ejects txs at the new price limit, and keeps them for the new lifetime.
+		Expect(ejectReason(5, 500)).To(MatchError(txpool.ErrTxUnderpriced))
+		Expect(ejectReason(6, 500)).To(Succeed())
+		Expect(*p.cfg).To(Equal(reloaded))
+	})
+
+	It("should only log the fields that require a restart", func() {
+		reloaded := *cfg
+		reloaded.Polar.LegacyTxPool.GlobalSlots++
+		reloaded.Polar.LegacyTxPool.GlobalQueue++
+		reloaded.Node.HTTPModules = []string{"eth"}
+
+		Expect(p.ReloadConfig(&reloaded)).To(BeEmpty())
+		Expect(loggedFields(logs, "polaris config change requires a restart")).To(ConsistOf(
+			"Polar.LegacyTxPool.GlobalSlots", "Polar.LegacyTxPool.GlobalQueue",
+			"Node.HTTPModules",
+		))
+		Expect(backend.gasTip).To(BeNil())
+		Expect(p.cfg.Polar.LegacyTxPool.GlobalSlots).To(Equal(cfg.Polar.LegacyTxPool.GlobalSlots))
+	})
+
+	It("should apply the reloadable fields of a mixed change", func() {
+		reloaded := *cfg
+		reloaded.Polar.LegacyTxPool.GlobalSlots++
+		reloaded.Polar.RPCGasCap = 1234
+
+		applied := p.ReloadConfig(&reloaded)
+		Expect(applied).To(HaveLen(1))
+		Expect(applied[0].Field).To(Equal("Polar.RPCGasCap"))
+		Expect(loggedFields(logs, "polaris config change requires a restart")).To(ConsistOf(
+			"Polar.LegacyTxPool.GlobalSlots",
+		))
+		Expect(backend.limits.GasCap).To(Equal(uint64(1234)))
+		Expect(p.cfg.Polar.LegacyTxPool.GlobalSlots).To(Equal(cfg.Polar.LegacyTxPool.GlobalSlots))
+	})
+
+	It("should keep the account slots that fail to be set", func() {
+		backend.accountSlotsErr = errors.New("unsupported legacy txpool")
+		reloaded := *cfg
+		reloaded.Polar.LegacyTxPool.AccountSlots++
+		reloaded.Polar.RPCGasCap = 1234
+
+		applied := p.ReloadConfig(&reloaded)
+		Expect(applied).To(HaveLen(1))
+		Expect(applied[0].Field).To(Equal("Polar.RPCGasCap"))
+		Expect(loggedFields(logs, "failed to apply polaris config change, a restart applies it")).
+			To(ConsistOf("Polar.LegacyTxPool.AccountSlots"))
+		Expect(p.cfg.Polar.LegacyTxPool.AccountSlots).To(Equal(cfg.Polar.LegacyTxPool.AccountSlots))
+	})
+})
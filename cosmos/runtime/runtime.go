@@ -26,10 +26,12 @@ package runtime
 import (
 	"math/big"
 	"sync"
+	"sync/atomic"
 
 	cosmoslog "cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/berachain/polaris/cosmos/config"
 	libtx "github.com/berachain/polaris/cosmos/lib/tx"
 	polarabci "github.com/berachain/polaris/cosmos/runtime/abci"
 	antelib "github.com/berachain/polaris/cosmos/runtime/ante"
@@ -42,6 +44,7 @@ import (
 	"github.com/berachain/polaris/eth/consensus"
 	"github.com/berachain/polaris/eth/core"
 	"github.com/berachain/polaris/eth/node"
+	"github.com/berachain/polaris/eth/polar"
 
 	cometabci "github.com/cometbft/cometbft/abci/types"
 
@@ -50,14 +53,22 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 
 	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	ethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 // EVMKeeper is an interface that defines the methods needed for the EVM setup.
 type EVMKeeper interface {
 	// Setup initializes the EVM keeper.
-	Setup(core.Blockchain, *txpool.Mempool) error
+	Setup(core.Blockchain, *txpool.Mempool, func(*params.ChainConfig)) error
+	// LoadChainConfig applies the on-chain chain config, failing if the local one conflicts.
+	LoadChainConfig(sdk.Context) error
+	// SetCommitMultiStore sets the multistore whose retained versions bound the EVM state.
+	SetCommitMultiStore(storetypes.CommitMultiStore)
+	// IsPrevRandao returns whether the random value of a block is derived from consensus data.
+	IsPrevRandao(ctx sdk.Context, time uint64) bool
 	GetStatePluginFactory() core.StatePluginFactory
 	GetHost() core.PolarisHostChain
 }
@@ -74,6 +85,25 @@ type CosmosApp interface {
 	BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error)
 }
 
+// rpcLimitsSetter replaces the limits applied to JSON-RPC requests.
+type rpcLimitsSetter interface {
+	SetRPCLimits(polar.RPCLimits)
+}
+
+// accountSlotsSetter sets the executable transaction slots guaranteed per account by the legacy
+// txpool.
+type accountSlotsSetter interface {
+	SetAccountSlots(uint64) error
+}
+
+// gethTxPool is the part of the geth txpool that is inspected and changed while the node is
+// running.
+type gethTxPool interface {
+	SetGasTip(*big.Int)
+	Content() (map[common.Address][]*ethtypes.Transaction, map[common.Address][]*ethtypes.Transaction)
+	ContentFrom(common.Address) ([]*ethtypes.Transaction, []*ethtypes.Transaction)
+}
+
 // Polaris is a struct that wraps the Polaris struct from the polar package.
 // It also includes wrapped versions of the Geth Miner and TxPool.
 type Polaris struct {
@@ -87,6 +117,23 @@ type Polaris struct {
 	WrappedTxPool *txpool.Mempool
 	// WrappedBlockchain is a wrapped version of the Blockchain component.
 	WrappedBlockchain *chain.WrappedBlockchain
+	// EventFeed streams the results of finalized blocks to the polaris_subscribe subscriptions.
+	// The application must pass it the result of each FinalizeBlock request.
+	EventFeed *comet.EventFeed
+	// cfg is the config that is currently applied, which is updated when the config is reloaded.
+	cfg *eth.Config
+	// reloadMu serializes the reloads of the config.
+	reloadMu sync.Mutex
+	// gethLogLevels are the minimum levels of geth logs per geth subsystem, which node operators
+	// can change over the admin API or by reloading the config.
+	gethLogLevels atomic.Pointer[config.GethLogLevels]
+	// rpcLimits, accountSlots and gethTxPool are the parts of the backend that are changed by
+	// config reloads and the admin API.
+	rpcLimits    rpcLimitsSetter
+	accountSlots accountSlotsSetter
+	gethTxPool   gethTxPool
+	// evmKeeper is the keeper of the evm module.
+	evmKeeper EVMKeeper
 	// logger is the underlying logger supplied by the sdk.
 	logger cosmoslog.Logger
 
@@ -105,8 +152,14 @@ func New(
 	engine consensus.Engine,
 ) *Polaris {
 	var err error
+	current := *cfg
 	p := &Polaris{
-		logger: logger,
+		EventFeed: comet.NewEventFeed(),
+		cfg:       &current,
+		logger:    logger,
+	}
+	if err = p.setGethLogLevel(cfg.GethLogLevel); err != nil {
+		panic(err)
 	}
 
 	ctx := sdk.Context{}.
@@ -117,12 +170,22 @@ func New(
 		WithEventManager(sdk.NewEventManager())
 	host.GetStatePluginFactory().SetLatestQueryContext(ctx)
 
+	// Surface every setting that was changed from its default, to ease debugging
+	// misconfigured nodes.
+	for _, diff := range config.DiffFromDefaults(cfg) {
+		logger.Info("polaris config differs from default",
+			"field", diff.Field, "value", diff.Value, "default", diff.Default)
+	}
+
 	if p.ExecutionLayer, err = eth.New(
 		"geth", cfg, host, engine, cfg.Node.AllowUnprotectedTxs,
-		ethlog.NewLogger(newEthHandler(logger)),
+		ethlog.NewLogger(newEthHandler(logger, &p.gethLogLevels)),
 	); err != nil {
 		panic(err)
 	}
+	p.rpcLimits = p.ExecutionLayer.Backend()
+	p.accountSlots = p.ExecutionLayer.Backend()
+	p.gethTxPool = p.ExecutionLayer.Backend().TxPool()
 
 	priceLimit := big.NewInt(0).SetUint64(cfg.Polar.LegacyTxPool.PriceLimit)
 	p.WrappedTxPool = txpool.New(
@@ -132,24 +195,43 @@ func New(
 		&p.blockBuilderMu,
 		priceLimit,
 	)
+	p.WrappedTxPool.SetTxPolicies(txPolicies(&cfg.TxPolicies)...)
+
+	// Consult the mempool's admission policies for transactions submitted over JSON-RPC.
+	p.ExecutionLayer.Backend().RegisterTxValidator(p.WrappedTxPool)
+	// Surface the CometBFT side status of transactions over JSON-RPC.
+	p.ExecutionLayer.Backend().RegisterTxStatusProvider(p.WrappedTxPool)
+	// Surface the Cosmos events emitted by precompiles over JSON-RPC.
+	if provider, ok := host.(polar.CosmosEventsProvider); ok {
+		p.ExecutionLayer.Backend().RegisterCosmosEventsProvider(provider)
+	}
+	// Stream the results of finalized blocks over the polaris_subscribe subscriptions.
+	p.ExecutionLayer.Backend().RegisterSubscriptionProvider(p.EventFeed)
+	// Serve the admin methods for node operators on the authenticated endpoint only.
+	p.ExecutionLayer.Stack().RegisterAPIs(
+		newAdminAPIs(p, host.GetStatePluginFactory()),
+	)
 
 	return p
 }
 
 // Build is a function that sets up the Polaris struct.
-// It takes a BaseApp and an EVMKeeper as arguments.
+// It takes a BaseApp and an EVMKeeper as arguments, along with the validator store used to pay
+// the priority fees of each block to its proposer and to verify that proposals do so. The
+// etherbase is paid, and proposals are not verified, if it is nil.
 // It returns an error if the setup fails.
 func (p *Polaris) Build(
-	app CosmosApp, cosmHandler sdk.AnteHandler, ek EVMKeeper, allowedValMsgs map[string]sdk.Msg,
-	hook chain.PostBlockHookFn, prepareProposal polarabci.PrepareProposalHook,
+	app CosmosApp, cosmHandler sdk.AnteHandler, ek EVMKeeper, vs miner.ValidatorStore,
+	allowedValMsgs map[string]sdk.Msg, hook chain.PostBlockHookFn,
+	prepareProposal polarabci.PrepareProposalHook,
 ) error {
 	// Wrap the geth miner and txpool with the cosmos miner and txpool.
 	p.WrappedMiner = miner.New(
-		p.ExecutionLayer.Backend().Miner(), app, allowedValMsgs,
+		p.ExecutionLayer.Backend().Miner(), app, vs, ek, allowedValMsgs,
 		p.Backend().Blockchain(), &p.blockBuilderMu,
 	)
 	p.WrappedBlockchain = chain.New(
-		p.ExecutionLayer.Backend().Blockchain(), app, hook,
+		p.ExecutionLayer.Backend().Blockchain(), app, vs, ek, hook,
 	)
 
 	p.ProposalProvider = polarabci.NewProposalProvider(
@@ -161,9 +243,15 @@ func (p *Polaris) Build(
 	app.SetPrepareProposal(p.ProposalProvider.PrepareProposal)
 	app.SetProcessProposal(p.ProposalProvider.ProcessProposal)
 
-	if err := ek.Setup(p.WrappedBlockchain, p.WrappedTxPool); err != nil {
+	if err := ek.Setup(p.WrappedBlockchain, p.WrappedTxPool, p.setChainConfig); err != nil {
 		return err
 	}
+	p.evmKeeper = ek
+
+	// Prune historical data in the background, outside of the consensus path.
+	if hp, ok := ek.GetHost().GetHistoricalPlugin().(node.Lifecycle); ok {
+		p.RegisterLifecycles([]node.Lifecycle{hp})
+	}
 
 	app.SetAnteHandler(
 		antelib.NewAnteHandler(p.WrappedTxPool, cosmHandler).AnteHandler(),
@@ -183,6 +271,11 @@ func (p *Polaris) SetupServices(clientCtx client.Context) error {
 	p.WrappedTxPool.Init(p.logger, clientCtx, libtx.NewSerializer[*ethtypes.Transaction](
 		clientCtx.TxConfig, evmtypes.WrapTx))
 
+	// Query the CometBFT mempool for the txpool status RPC, if the node's client allows it.
+	if hostMempool, ok := clientCtx.Client.(txpool.HostMempool); ok {
+		p.WrappedTxPool.SetHostMempool(hostMempool, clientCtx.TxConfig.TxDecoder())
+	}
+
 	// Register services with Polaris.
 	p.RegisterLifecycles([]node.Lifecycle{
 		p.WrappedTxPool,
@@ -227,8 +320,41 @@ func (p *Polaris) LoadLastState(cms storetypes.CommitMultiStore, appHeight uint6
 		WithGasMeter(storetypes.NewInfiniteGasMeter()).
 		WithBlockGasMeter(storetypes.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
 
+	// Refuse to start if the local chain config conflicts with the on-chain one.
+	if err := p.evmKeeper.LoadChainConfig(cmsCtx); err != nil {
+		return err
+	}
+
+	p.evmKeeper.SetCommitMultiStore(cms)
 	bc := p.Backend().Blockchain()
 	bc.StatePluginFactory().SetLatestQueryContext(cmsCtx)
 	bc.PrimePlugins(cmsCtx)
 	return bc.LoadLastState(appHeight)
 }
+
+// setChainConfig applies the given chain config to the blockchain and the miner. It holds the
+// block builder lock, so that the config does not change while a block is being built.
+func (p *Polaris) setChainConfig(cfg *params.ChainConfig) {
+	p.blockBuilderMu.Lock()
+	defer p.blockBuilderMu.Unlock()
+	p.Backend().SetChainConfig(cfg)
+}
+
+// txPolicies returns the txpool admission policies enabled by the given config.
+func txPolicies(cfg *eth.TxPolicyConfig) []txpool.TxPolicy {
+	var policies []txpool.TxPolicy
+	if len(cfg.DenyList) > 0 {
+		policies = append(policies, txpool.NewDenyListPolicy(cfg.DenyList...))
+	}
+	if cfg.MaxCalldataSize > 0 {
+		policies = append(policies, txpool.NewMaxCalldataPolicy(cfg.MaxCalldataSize))
+	}
+	if cfg.RestrictContractCreation {
+		policies = append(policies, txpool.NewContractCreationPolicy(cfg.Deployers...))
+	}
+	if cfg.SenderRateLimit > 0 {
+		policies = append(policies,
+			txpool.NewSenderRateLimitPolicy(cfg.SenderRateLimit, cfg.SenderRateLimitWindow))
+	}
+	return policies
+}
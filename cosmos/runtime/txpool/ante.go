@@ -25,6 +25,7 @@ package txpool
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/berachain/polaris/cosmos/x/evm/types"
@@ -36,6 +37,21 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
+var (
+	// ErrTxExpired is returned when a transaction has been in the mempool for longer than the
+	// configured lifetime.
+	ErrTxExpired = errors.New("tx exceeded the mempool lifetime")
+	// ErrTxUnderpriced is returned when a transaction's gas price is at or below the configured
+	// price limit.
+	ErrTxUnderpriced = errors.New("tx gas price at or below the price limit")
+	// ErrTxIncluded is returned when a transaction has already been included in the canonical
+	// chain.
+	ErrTxIncluded = errors.New("tx included in the canonical chain")
+	// ErrTxEvicted is returned when a transaction has been evicted from the mempool by the node
+	// operator.
+	ErrTxEvicted = errors.New("tx evicted by the node operator")
+)
+
 // AnteHandle implements sdk.AnteHandler.
 // It is used to determine whether transactions should be ejected
 // from the comet mempool.
@@ -53,43 +69,57 @@ func (m *Mempool) AnteHandle(
 	if ctx.ExecMode() == sdk.ExecModeCheck || ctx.ExecMode() == sdk.ExecModeReCheck {
 		if wet, ok := utils.GetAs[*types.WrappedEthereumTransaction](msgs[0]); ok {
 			ethTx := wet.Unwrap()
-			if shouldEject := m.shouldEjectFromCometMempool(
+			if reason := m.shouldEjectFromCometMempool(
 				ctx.BlockTime().Unix(), ethTx,
-			); shouldEject {
+			); reason != nil {
+				telemetry.IncrCounter(float32(1), MetricKeyAnteEjectedTxs)
+				m.tsc.MarkEjected(ethTx.Hash(), reason)
+				return ctx, fmt.Errorf("eject from comet mempool: %w", reason)
+			}
+			// Eject transactions that violate the admission policies, surfacing the reason.
+			if err := m.ValidateTx(ethTx); err != nil {
+				telemetry.IncrCounter(float32(1), MetricKeyAnteShouldEjectPolicy)
 				telemetry.IncrCounter(float32(1), MetricKeyAnteEjectedTxs)
-				return ctx, errors.New("eject from comet mempool")
+				m.tsc.MarkEjected(ethTx.Hash(), err)
+				return ctx, err
 			}
 		}
 	}
 	return next(ctx, tx, simulate)
 }
 
-// shouldEject returns true if the transaction should be ejected from the CometBFT mempool.
+// shouldEjectFromCometMempool returns the reason the transaction should be ejected from the
+// CometBFT mempool, or nil if it should be kept.
 func (m *Mempool) shouldEjectFromCometMempool(
 	currentTime int64, tx *ethtypes.Transaction,
-) bool {
+) error {
 	defer telemetry.MeasureSince(time.Now(), MetricKeyTimeShouldEject)
 	if tx == nil {
-		return false
+		return nil
 	}
 
 	// First check things that are stateless.
-	if m.validateStateless(tx, currentTime) {
-		return true
+	if err := m.validateStateless(tx, currentTime); err != nil {
+		return err
 	}
 
 	// Then check for things that are stateful.
 	return m.validateStateful(tx)
 }
 
-// validateStateless returns whether the tx of the given hash is stateless.
-func (m *Mempool) validateStateless(tx *ethtypes.Transaction, currentTime int64) bool {
+// validateStateless returns the reason the tx should be ejected based on stateless checks.
+func (m *Mempool) validateStateless(tx *ethtypes.Transaction, currentTime int64) error {
 	txHash := tx.Hash()
-	// 1. If the transaction has been in the mempool for longer than the configured timeout.
-	// 2. If the transaction's gas params are less than or equal to the configured limit.
-	expired := currentTime-m.crc.TimeFirstSeen(txHash) > m.lifetime
-	priceLeLimit := tx.GasPrice().Cmp(m.priceLimit) <= 0
-
+	// 1. If the transaction has been evicted by the node operator.
+	// 2. If the transaction has been in the mempool for longer than the configured timeout.
+	// 3. If the transaction's gas params are less than or equal to the configured limit.
+	evicted := m.evicted.Contains(txHash)
+	expired := currentTime-m.crc.TimeFirstSeen(txHash) > m.lifetime.Load()
+	priceLeLimit := tx.GasPrice().Cmp(m.priceLimit.Load()) <= 0
+
+	if evicted {
+		telemetry.IncrCounter(float32(1), MetricKeyAnteShouldEjectEvicted)
+	}
 	if expired {
 		telemetry.IncrCounter(float32(1), MetricKeyAnteShouldEjectExpiredTx)
 	}
@@ -97,12 +127,21 @@ func (m *Mempool) validateStateless(tx *ethtypes.Transaction, currentTime int64)
 		telemetry.IncrCounter(float32(1), MetricKeyAnteShouldEjectPriceLimit)
 	}
 
-	return expired || priceLeLimit
+	switch {
+	case evicted:
+		return ErrTxEvicted
+	case expired:
+		return ErrTxExpired
+	case priceLeLimit:
+		return ErrTxUnderpriced
+	default:
+		return nil
+	}
 }
 
-// includedCanonicalChain returns whether the tx of the given hash is included in the canonical
-// Eth chain.
-func (m *Mempool) validateStateful(tx *ethtypes.Transaction) bool {
+// validateStateful returns the reason the tx should be ejected based on whether it is included
+// in the canonical Eth chain.
+func (m *Mempool) validateStateful(tx *ethtypes.Transaction) error {
 	// // 1. If the transaction has been included in a block.
 	// signer := ethtypes.LatestSignerForChainID(m.chainConfig.ChainID)
 	// if _, err := ethtypes.Sender(signer, tx); err != nil {
@@ -112,5 +151,8 @@ func (m *Mempool) validateStateful(tx *ethtypes.Transaction) bool {
 	// tx.Nonce() <
 	included := m.chain.GetTransactionLookup(tx.Hash()) != nil
 	telemetry.IncrCounter(float32(1), MetricKeyAnteShouldEjectInclusion)
-	return included
+	if included {
+		return ErrTxIncluded
+	}
+	return nil
 }
@@ -34,6 +34,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/event"
@@ -56,6 +57,7 @@ type SdkTx interface {
 // TxSubProvider.
 type TxSubProvider interface {
 	SubscribeTransactions(ch chan<- core.NewTxsEvent, reorgs bool) event.Subscription
+	Get(hash common.Hash) *ethtypes.Transaction
 	Stats() (int, int)
 }
 
@@ -89,6 +91,7 @@ type handler struct {
 	clientCtx  TxBroadcaster
 	serializer TxSerializer
 	crc        CometRemoteCache
+	tsc        *txStatusCache
 
 	// Ethereum
 	txPool  TxSubProvider
@@ -104,13 +107,14 @@ type handler struct {
 // newHandler creates a new handler.
 func newHandler(
 	clientCtx TxBroadcaster, txPool TxSubProvider, serializer TxSerializer,
-	crc CometRemoteCache, logger log.Logger,
+	crc CometRemoteCache, tsc *txStatusCache, logger log.Logger,
 ) *handler {
 	h := &handler{
 		logger:     logger,
 		clientCtx:  clientCtx,
 		serializer: serializer,
 		crc:        crc,
+		tsc:        tsc,
 		txPool:     txPool,
 		txsCh:      make(chan core.NewTxsEvent, txChanSize),
 		stopCh:     make(chan struct{}),
@@ -176,6 +180,7 @@ func (h *handler) failedLoop() {
 				continue
 			}
 			telemetry.IncrCounter(float32(1), MetricKeyBroadcastRetry)
+			h.tsc.MarkBroadcastRetry(failed.tx.Hash())
 			h.broadcastTransaction(failed.tx, failed.retries-1)
 		}
 
@@ -228,7 +233,7 @@ func (h *handler) broadcastTransactions(txs ethtypes.Transactions) {
 	numBroadcasted := 0
 	for _, signedEthTx := range txs {
 		if !h.crc.IsRemoteTx(signedEthTx.Hash()) {
-			h.broadcastTransaction(signedEthTx, maxRetries)
+			h.broadcastTransaction(h.withBlobTxSidecar(signedEthTx), maxRetries)
 			numBroadcasted++
 		}
 	}
@@ -237,6 +242,19 @@ func (h *handler) broadcastTransactions(txs ethtypes.Transactions) {
 	)
 }
 
+// withBlobTxSidecar returns the given transaction along with its blob sidecar. The txpool strips
+// the sidecars of blob transactions from its feed, so they are fetched from the txpool in order
+// to gossip the blobs to peers.
+func (h *handler) withBlobTxSidecar(tx *ethtypes.Transaction) *ethtypes.Transaction {
+	if tx.Type() != ethtypes.BlobTxType || tx.BlobTxSidecar() != nil {
+		return tx
+	}
+	if full := h.txPool.Get(tx.Hash()); full != nil {
+		return full
+	}
+	return tx
+}
+
 // broadcastTransaction will propagate a transaction to the CometBFT mempool.
 func (h *handler) broadcastTransaction(tx *ethtypes.Transaction, retries int) {
 	txBytes, err := h.serializer.ToSdkTxBytes(tx, tx.Gas())
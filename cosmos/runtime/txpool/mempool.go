@@ -28,6 +28,7 @@ import (
 	"errors"
 	"math/big"
 	"sync"
+	"sync/atomic"
 
 	"cosmossdk.io/log"
 
@@ -40,6 +41,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
 	ethtxpool "github.com/ethereum/go-ethereum/core/txpool"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
@@ -67,12 +70,20 @@ type GethTxPool interface {
 // geth txpool during `CheckTx`, that is the only purpose of `Mempool“.
 type Mempool struct {
 	eth.TxPool
-	lifetime       int64
+	lifetime       atomic.Int64
 	chain          core.ChainReader
 	handler        Lifecycle
 	crc            CometRemoteCache
+	tsc            *txStatusCache
 	blockBuilderMu *sync.RWMutex
-	priceLimit     *big.Int
+	priceLimit     atomic.Pointer[big.Int]
+	policies       TxPolicies
+	// evicted is the set of txs that were evicted by the node operator, which are ejected from
+	// the CometBFT mempool on their next recheck.
+	evicted *lru.Cache[common.Hash, struct{}]
+	// hostMempool is the CometBFT mempool, whose txs are decoded with txDecoder.
+	hostMempool HostMempool
+	txDecoder   sdk.TxDecoder
 }
 
 // New creates a new Mempool.
@@ -80,14 +91,29 @@ func New(
 	chain core.ChainReader, txpool eth.TxPool, lifetime int64,
 	blockBuilderMu *sync.RWMutex, priceLimit *big.Int,
 ) *Mempool {
-	return &Mempool{
+	m := &Mempool{
 		TxPool:         txpool,
 		chain:          chain,
-		lifetime:       lifetime,
 		crc:            newCometRemoteCache(),
+		tsc:            newTxStatusCache(),
 		blockBuilderMu: blockBuilderMu,
-		priceLimit:     priceLimit,
+		evicted:        lru.NewCache[common.Hash, struct{}](defaultCacheSize),
 	}
+	m.SetLifetime(lifetime)
+	m.SetPriceLimit(priceLimit)
+	return m
+}
+
+// SetLifetime sets how long a transaction may stay in the CometBFT mempool before it is ejected.
+// It is safe to call while the node is running.
+func (m *Mempool) SetLifetime(lifetime int64) {
+	m.lifetime.Store(lifetime)
+}
+
+// SetPriceLimit sets the gas price at or below which transactions are ejected from the CometBFT
+// mempool. It is safe to call while the node is running.
+func (m *Mempool) SetPriceLimit(priceLimit *big.Int) {
+	m.priceLimit.Store(new(big.Int).Set(priceLimit))
 }
 
 // Init initializes the Mempool (notably the TxHandler).
@@ -96,7 +122,25 @@ func (m *Mempool) Init(
 	txBroadcaster TxBroadcaster,
 	txSerializer TxSerializer,
 ) {
-	m.handler = newHandler(txBroadcaster, m.TxPool, txSerializer, m.crc, logger)
+	m.handler = newHandler(txBroadcaster, m.TxPool, txSerializer, m.crc, m.tsc, logger)
+}
+
+// SetTxPolicies sets the ordered chain of policies that every transaction must satisfy to be
+// admitted into, and to remain in, the mempool. It must be called before the node starts.
+func (m *Mempool) SetTxPolicies(policies ...TxPolicy) {
+	m.policies = policies
+}
+
+// ValidateTx runs the transaction through the configured policies, returning the reject
+// reason of the first violated policy.
+func (m *Mempool) ValidateTx(tx *ethtypes.Transaction) error {
+	return m.policies.Validate(tx)
+}
+
+// RecordTx records the transaction, which the txpool accepted, with the configured policies that
+// keep state about the transactions they admit.
+func (m *Mempool) RecordTx(tx *ethtypes.Transaction) {
+	m.policies.Record(tx)
 }
 
 // Start starts the Mempool TxHandler.
@@ -126,6 +170,16 @@ func (m *Mempool) Insert(ctx context.Context, sdkTx sdk.Tx) error {
 	// Add the eth tx to the Geth txpool.
 	ethTx := wet.Unwrap()
 
+	// Do not readmit txs that were evicted by the node operator, e.g. when gossiped back.
+	if m.evicted.Contains(ethTx.Hash()) {
+		return ErrTxEvicted
+	}
+
+	// Ensure the tx is allowed by the chain's admission policies.
+	if err := m.ValidateTx(ethTx); err != nil {
+		return err
+	}
+
 	// Insert the tx into the txpool as a remote.
 	m.blockBuilderMu.RLock()
 	errs := m.TxPool.Add([]*ethtypes.Transaction{ethTx}, false, false)
@@ -137,17 +191,33 @@ func (m *Mempool) Insert(ctx context.Context, sdkTx sdk.Tx) error {
 		(sCtx.ExecMode() == sdk.ExecModeCheck || sCtx.ExecMode() == sdk.ExecModeReCheck) {
 		telemetry.IncrCounter(float32(1), MetricKeyMempoolKnownTxs)
 		sCtx.Logger().Info("mempool insert: tx already in mempool", "mode", sCtx.ExecMode())
+		m.tsc.MarkBroadcast(ethTx.Hash())
 		return nil
 	} else if errs[0] != nil {
 		return errs[0]
 	}
 
+	// Count the tx against the admission policies only now that the txpool accepted it.
+	m.RecordTx(ethTx)
+
 	// Add the eth tx to the remote cache.
 	_ = m.crc.MarkRemoteSeen(ethTx.Hash())
+	m.tsc.MarkBroadcast(ethTx.Hash())
 
 	return nil
 }
 
+// Evict removes the txs with the given hashes from the txpool, and ejects them from the CometBFT
+// mempool on their next recheck.
+func (m *Mempool) Evict(txHashes ...common.Hash) {
+	m.blockBuilderMu.Lock()
+	defer m.blockBuilderMu.Unlock()
+	for _, txHash := range txHashes {
+		m.TxPool.Remove(txHash)
+		m.evicted.Add(txHash, struct{}{})
+	}
+}
+
 // CountTx returns the number of transactions currently in the mempool.
 func (m *Mempool) CountTx() int {
 	runnable, blocked := m.TxPool.Stats()
@@ -3,9 +3,13 @@
 package mocks
 
 import (
+	common "github.com/ethereum/go-ethereum/common"
 	core "github.com/ethereum/go-ethereum/core"
+
 	event "github.com/ethereum/go-ethereum/event"
 
+	types "github.com/ethereum/go-ethereum/core/types"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -22,6 +26,54 @@ func (_m *TxSubProvider) EXPECT() *TxSubProvider_Expecter {
 	return &TxSubProvider_Expecter{mock: &_m.Mock}
 }
 
+// Get provides a mock function with given fields: hash
+func (_m *TxSubProvider) Get(hash common.Hash) *types.Transaction {
+	ret := _m.Called(hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *types.Transaction
+	if rf, ok := ret.Get(0).(func(common.Hash) *types.Transaction); ok {
+		r0 = rf(hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Transaction)
+		}
+	}
+
+	return r0
+}
+
+// TxSubProvider_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type TxSubProvider_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - hash common.Hash
+func (_e *TxSubProvider_Expecter) Get(hash interface{}) *TxSubProvider_Get_Call {
+	return &TxSubProvider_Get_Call{Call: _e.mock.On("Get", hash)}
+}
+
+func (_c *TxSubProvider_Get_Call) Run(run func(hash common.Hash)) *TxSubProvider_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(common.Hash))
+	})
+	return _c
+}
+
+func (_c *TxSubProvider_Get_Call) Return(_a0 *types.Transaction) *TxSubProvider_Get_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TxSubProvider_Get_Call) RunAndReturn(run func(common.Hash) *types.Transaction) *TxSubProvider_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SubscribeTransactions provides a mock function with given fields: ch, reorgs
 func (_m *TxSubProvider) SubscribeTransactions(ch chan<- core.NewTxsEvent, reorgs bool) event.Subscription {
 	ret := _m.Called(ch, reorgs)
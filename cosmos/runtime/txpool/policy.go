@@ -0,0 +1,317 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package txpool
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	ethtxpool "github.com/ethereum/go-ethereum/core/txpool"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrTxPolicyViolation is returned when a transaction is rejected by a TxPolicy.
+var ErrTxPolicyViolation = errors.New("tx rejected by policy")
+
+// TxPolicy is a chain-specific admission rule for Ethereum transactions. Policies are consulted
+// in order when a transaction is inserted into the mempool (from CometBFT or JSON-RPC) and again
+// on every (Re)CheckTx, where a violation ejects the transaction from the CometBFT mempool.
+type TxPolicy interface {
+	// Name returns the name of the policy, used in reject reasons and telemetry.
+	Name() string
+	// Validate returns a non-nil error describing why the transaction, sent by `sender`, is not
+	// allowed by the policy.
+	Validate(tx *ethtypes.Transaction, sender common.Address) error
+}
+
+// TxRecorder is implemented by the TxPolicies that keep state about the transactions they admit.
+// Validate only checks a transaction against that state, while Record adds the transaction to it
+// once the txpool has accepted it, so that transactions rejected by the txpool are not counted.
+type TxRecorder interface {
+	// Record records that the transaction, sent by `sender`, was accepted by the txpool.
+	Record(tx *ethtypes.Transaction, sender common.Address)
+}
+
+// TxPolicies is an ordered chain of TxPolicy. The first violation short-circuits the chain.
+type TxPolicies []TxPolicy
+
+// Validate runs the transaction through every policy in order and returns the reject reason of
+// the first policy that is violated.
+func (ps TxPolicies) Validate(tx *ethtypes.Transaction) error {
+	if len(ps) == 0 {
+		return nil
+	}
+
+	sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ethtxpool.ErrInvalidSender, err)
+	}
+
+	for _, p := range ps {
+		if err = p.Validate(tx, sender); err != nil {
+			telemetry.IncrCounter(float32(1), MetricKeyPolicyRejectedTxs, p.Name())
+			return fmt.Errorf("%w %s: %w", ErrTxPolicyViolation, p.Name(), err)
+		}
+	}
+	return nil
+}
+
+// Record records the transaction, which the txpool accepted, with every policy that is a
+// TxRecorder.
+func (ps TxPolicies) Record(tx *ethtypes.Transaction) {
+	if len(ps) == 0 {
+		return
+	}
+
+	sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return
+	}
+
+	for _, p := range ps {
+		if r, ok := p.(TxRecorder); ok {
+			r.Record(tx, sender)
+		}
+	}
+}
+
+// =============================================================================
+// DenyList
+// =============================================================================
+
+// denyListPolicy rejects transactions sent from or to any of the denied addresses.
+type denyListPolicy struct {
+	denied map[common.Address]struct{}
+}
+
+// NewDenyListPolicy returns a TxPolicy that rejects transactions whose sender or recipient is
+// one of the given addresses.
+func NewDenyListPolicy(addrs ...common.Address) TxPolicy {
+	denied := make(map[common.Address]struct{}, len(addrs))
+	for _, addr := range addrs {
+		denied[addr] = struct{}{}
+	}
+	return &denyListPolicy{denied: denied}
+}
+
+// Name implements TxPolicy.
+func (*denyListPolicy) Name() string {
+	return "deny_list"
+}
+
+// Validate implements TxPolicy.
+func (p *denyListPolicy) Validate(tx *ethtypes.Transaction, sender common.Address) error {
+	if _, found := p.denied[sender]; found {
+		return fmt.Errorf("sender %s is denied", sender.Hex())
+	}
+	if to := tx.To(); to != nil {
+		if _, found := p.denied[*to]; found {
+			return fmt.Errorf("recipient %s is denied", to.Hex())
+		}
+	}
+	return nil
+}
+
+// =============================================================================
+// MaxCalldata
+// =============================================================================
+
+// maxCalldataPolicy rejects transactions with calldata larger than a configured size.
+type maxCalldataPolicy struct {
+	maxSize int
+}
+
+// NewMaxCalldataPolicy returns a TxPolicy that rejects transactions with more than `maxSize`
+// bytes of calldata.
+func NewMaxCalldataPolicy(maxSize int) TxPolicy {
+	return &maxCalldataPolicy{maxSize: maxSize}
+}
+
+// Name implements TxPolicy.
+func (*maxCalldataPolicy) Name() string {
+	return "max_calldata"
+}
+
+// Validate implements TxPolicy.
+func (p *maxCalldataPolicy) Validate(tx *ethtypes.Transaction, _ common.Address) error {
+	if size := len(tx.Data()); size > p.maxSize {
+		return fmt.Errorf("calldata size %d exceeds limit %d", size, p.maxSize)
+	}
+	return nil
+}
+
+// =============================================================================
+// ContractCreation
+// =============================================================================
+
+// contractCreationPolicy only allows contract creation transactions from allowed deployers.
+type contractCreationPolicy struct {
+	deployers map[common.Address]struct{}
+}
+
+// NewContractCreationPolicy returns a TxPolicy that only allows the given deployers to send
+// contract creation transactions. If no deployers are given, contract creation is disabled.
+func NewContractCreationPolicy(deployers ...common.Address) TxPolicy {
+	allowed := make(map[common.Address]struct{}, len(deployers))
+	for _, addr := range deployers {
+		allowed[addr] = struct{}{}
+	}
+	return &contractCreationPolicy{deployers: allowed}
+}
+
+// Name implements TxPolicy.
+func (*contractCreationPolicy) Name() string {
+	return "contract_creation"
+}
+
+// Validate implements TxPolicy.
+func (p *contractCreationPolicy) Validate(tx *ethtypes.Transaction, sender common.Address) error {
+	if tx.To() != nil {
+		return nil
+	}
+	if _, found := p.deployers[sender]; !found {
+		return fmt.Errorf("contract creation not allowed for %s", sender.Hex())
+	}
+	return nil
+}
+
+// =============================================================================
+// SenderRateLimit
+// =============================================================================
+
+// maxRateLimitedSenders is the maximum number of senders whose rate is tracked. Beyond it, the
+// senders seen least recently are forgotten before their window ends.
+const maxRateLimitedSenders = 1 << 16
+
+// senderRateLimitPolicy limits the number of new transactions a sender can submit per window.
+// Transactions are counted by sender and nonce once the txpool accepts them, so that a
+// transaction being rechecked, re-gossiped or replaced is never counted twice.
+type senderRateLimitPolicy struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Duration
+	now    func() time.Time
+	// senders are the rates of the senders seen in the last window, the one seen least recently
+	// being the oldest, so that expired senders are evicted without walking every sender.
+	senders lru.BasicLRU[common.Address, *senderRate]
+}
+
+// senderRate is the rate of a sender, which is the number of nonces counted since `start`.
+type senderRate struct {
+	start time.Time
+	// lastSeen is when a transaction of the sender was last validated, so that the nonces of
+	// its pending transactions are remembered as long as they are rechecked.
+	lastSeen time.Time
+	count    int
+	// counted are the nonces counted in the current window.
+	counted map[uint64]struct{}
+	// maxPrevCounted is the highest nonce counted in a previous window, if hasPrev.
+	maxPrevCounted uint64
+	hasPrev        bool
+}
+
+// NewSenderRateLimitPolicy returns a TxPolicy that allows each sender to submit at most `limit`
+// distinct transactions per `window`.
+func NewSenderRateLimitPolicy(limit int, window time.Duration) TxPolicy {
+	return &senderRateLimitPolicy{
+		limit:   limit,
+		window:  window,
+		now:     time.Now,
+		senders: lru.NewBasicLRU[common.Address, *senderRate](maxRateLimitedSenders),
+	}
+}
+
+// Name implements TxPolicy.
+func (*senderRateLimitPolicy) Name() string {
+	return "sender_rate_limit"
+}
+
+// Validate implements TxPolicy.
+func (p *senderRateLimitPolicy) Validate(tx *ethtypes.Transaction, sender common.Address) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	r := p.rate(sender, false)
+	if r == nil || r.isCounted(tx.Nonce()) || r.count < p.limit {
+		return nil
+	}
+	return fmt.Errorf("sender %s exceeded %d txs per %s", sender.Hex(), p.limit, p.window)
+}
+
+// Record implements TxRecorder.
+func (p *senderRateLimitPolicy) Record(tx *ethtypes.Transaction, sender common.Address) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	r := p.rate(sender, true)
+	if nonce := tx.Nonce(); !r.isCounted(nonce) {
+		r.count++
+		r.counted[nonce] = struct{}{}
+	}
+}
+
+// rate returns the rate of the given sender in the current window, which is created if `create`
+// is set and returned as nil otherwise. The senders that have not been seen for a window are
+// dropped, so that the tracked senders do not grow unbounded.
+func (p *senderRateLimitPolicy) rate(sender common.Address, create bool) *senderRate {
+	now := p.now()
+	for {
+		_, oldest, ok := p.senders.GetOldest()
+		if !ok || now.Sub(oldest.lastSeen) < p.window {
+			break
+		}
+		p.senders.RemoveOldest()
+	}
+
+	r, found := p.senders.Get(sender)
+	switch {
+	case !found && !create:
+		return nil
+	case !found:
+		r = &senderRate{start: now, counted: make(map[uint64]struct{})}
+		p.senders.Add(sender, r)
+	case now.Sub(r.start) >= p.window:
+		// Start a new window, remembering the nonces counted in the previous ones.
+		for nonce := range r.counted {
+			if !r.hasPrev || nonce > r.maxPrevCounted {
+				r.maxPrevCounted, r.hasPrev = nonce, true
+			}
+		}
+		r.start, r.count, r.counted = now, 0, make(map[uint64]struct{})
+	}
+	r.lastSeen = now
+	return r
+}
+
+// isCounted returns whether the given nonce was counted in the current or a previous window.
+func (r *senderRate) isCounted(nonce uint64) bool {
+	_, ok := r.counted[nonce]
+	return ok || (r.hasPrev && nonce <= r.maxPrevCounted)
+}
@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package txpool
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TxPolicies", func() {
+	var (
+		key    *ecdsa.PrivateKey
+		sender common.Address
+		signer ethtypes.Signer
+		to     = common.HexToAddress("0x1234")
+	)
+
+	signTx := func(nonce uint64, to *common.Address, data []byte) *ethtypes.Transaction {
+		tx, err := ethtypes.SignNewTx(key, signer, &ethtypes.DynamicFeeTx{
+			ChainID: big.NewInt(2061), Nonce: nonce, To: to, Data: data, Gas: 21000,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return tx
+	}
+
+	BeforeEach(func() {
+		var err error
+		key, err = crypto.GenerateKey()
+		Expect(err).ToNot(HaveOccurred())
+		sender = crypto.PubkeyToAddress(key.PublicKey)
+		signer = ethtypes.LatestSignerForChainID(big.NewInt(2061))
+	})
+
+	It("should allow any tx with no policies", func() {
+		Expect(TxPolicies(nil).Validate(signTx(0, &to, nil))).To(Succeed())
+	})
+
+	It("should reject denied senders and recipients", func() {
+		Expect(TxPolicies{NewDenyListPolicy(sender)}.Validate(signTx(0, &to, nil))).
+			To(MatchError(ErrTxPolicyViolation))
+		Expect(TxPolicies{NewDenyListPolicy(to)}.Validate(signTx(0, &to, nil))).
+			To(MatchError(ContainSubstring("deny_list")))
+		Expect(TxPolicies{NewDenyListPolicy(common.Address{1})}.Validate(signTx(0, &to, nil))).
+			To(Succeed())
+	})
+
+	It("should reject oversized calldata", func() {
+		policies := TxPolicies{NewMaxCalldataPolicy(4)}
+		Expect(policies.Validate(signTx(0, &to, []byte{1, 2, 3, 4}))).To(Succeed())
+		Expect(policies.Validate(signTx(0, &to, []byte{1, 2, 3, 4, 5}))).
+			To(MatchError(ContainSubstring("max_calldata")))
+	})
+
+	It("should restrict contract creation to allowed deployers", func() {
+		Expect(TxPolicies{NewContractCreationPolicy()}.Validate(signTx(0, nil, nil))).
+			To(MatchError(ContainSubstring("contract_creation")))
+		Expect(TxPolicies{NewContractCreationPolicy(sender)}.Validate(signTx(0, nil, nil))).
+			To(Succeed())
+		Expect(TxPolicies{NewContractCreationPolicy()}.Validate(signTx(0, &to, nil))).
+			To(Succeed())
+	})
+
+	Describe("sender rate limit", func() {
+		var (
+			now      time.Time
+			policy   *senderRateLimitPolicy
+			policies TxPolicies
+		)
+
+		// admit validates the tx and records it, as the txpool accepts every valid tx.
+		admit := func(tx *ethtypes.Transaction) error {
+			if err := policies.Validate(tx); err != nil {
+				return err
+			}
+			policies.Record(tx)
+			return nil
+		}
+
+		BeforeEach(func() {
+			now = time.Unix(1000, 0)
+			var ok bool
+			policy, ok = NewSenderRateLimitPolicy(2, time.Minute).(*senderRateLimitPolicy)
+			Expect(ok).To(BeTrue())
+			policy.now = func() time.Time { return now }
+			policies = TxPolicies{policy}
+		})
+
+		It("should rate limit distinct txs per sender", func() {
+			tx0, tx1 := signTx(0, &to, nil), signTx(1, &to, nil)
+			Expect(admit(tx0)).To(Succeed())
+			Expect(admit(tx1)).To(Succeed())
+			// Revalidating an admitted tx is not counted again.
+			Expect(admit(tx0)).To(Succeed())
+			Expect(admit(signTx(2, &to, nil))).
+				To(MatchError(ContainSubstring("sender_rate_limit")))
+
+			// A replacement of an admitted tx is not counted again either.
+			Expect(admit(signTx(1, &to, []byte{1}))).To(Succeed())
+
+			// The pending txs are rechecked, so their sender is not forgotten.
+			now = now.Add(time.Minute / 2)
+			Expect(policies.Validate(tx0)).To(Succeed())
+			now = now.Add(time.Minute / 2)
+			Expect(admit(signTx(2, &to, nil))).To(Succeed())
+			// Rechecking the txs counted in a previous window does not count them again.
+			Expect(admit(tx0)).To(Succeed())
+			Expect(admit(tx1)).To(Succeed())
+			Expect(admit(signTx(3, &to, nil))).To(Succeed())
+			Expect(admit(signTx(4, &to, nil))).
+				To(MatchError(ContainSubstring("sender_rate_limit")))
+		})
+
+		It("should only count the txs accepted by the txpool", func() {
+			// Txs that are validated but rejected by the txpool are not counted.
+			for nonce := uint64(0); nonce < 5; nonce++ {
+				Expect(policies.Validate(signTx(nonce, &to, nil))).To(Succeed())
+			}
+			Expect(policy.senders.Len()).To(BeZero())
+
+			Expect(admit(signTx(0, &to, nil))).To(Succeed())
+			Expect(admit(signTx(1, &to, nil))).To(Succeed())
+			Expect(policies.Validate(signTx(2, &to, nil))).
+				To(MatchError(ContainSubstring("sender_rate_limit")))
+		})
+
+		It("should forget the senders that have not been seen for a window", func() {
+			Expect(admit(signTx(0, &to, nil))).To(Succeed())
+			other, err := crypto.GenerateKey()
+			Expect(err).ToNot(HaveOccurred())
+			otherTx, err := ethtypes.SignNewTx(other, signer, &ethtypes.DynamicFeeTx{
+				ChainID: big.NewInt(2061), To: &to, Gas: 21000,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			now = now.Add(time.Minute / 2)
+			Expect(admit(otherTx)).To(Succeed())
+			Expect(policy.senders.Len()).To(Equal(2))
+
+			// Only the sender seen a window ago is forgotten.
+			now = now.Add(time.Minute / 2)
+			Expect(policies.Validate(otherTx)).To(Succeed())
+			Expect(policy.senders.Keys()).To(Equal(
+				[]common.Address{crypto.PubkeyToAddress(other.PublicKey)},
+			))
+		})
+	})
+
+	It("should stop at the first violated policy", func() {
+		policies := TxPolicies{NewMaxCalldataPolicy(0), NewDenyListPolicy(sender)}
+		Expect(policies.Validate(signTx(0, &to, []byte{1}))).
+			To(MatchError(ContainSubstring("max_calldata")))
+	})
+})
@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package txpool
+
+import (
+	"context"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+	"github.com/berachain/polaris/eth/polar"
+	polarapi "github.com/berachain/polaris/eth/polar/api"
+	"github.com/berachain/polaris/lib/utils"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+)
+
+// maxHostMempoolTxs is the number of txs fetched from the CometBFT mempool when looking a tx up,
+// which is the largest page that its `unconfirmed_txs` RPC returns.
+const maxHostMempoolTxs = 100
+
+// Mempool implements the polar.TxStatusProvider interface.
+var _ polar.TxStatusProvider = (*Mempool)(nil)
+
+// HostMempool is the part of the CometBFT RPC client that lists the txs in its mempool.
+type HostMempool interface {
+	UnconfirmedTxs(ctx context.Context, limit *int) (*coretypes.ResultUnconfirmedTxs, error)
+}
+
+// txStatusCache records how the CometBFT mempool and the broadcast handler have dealt with
+// transactions, so that stuck transactions can be debugged over JSON-RPC.
+type txStatusCache struct {
+	// retries is the number of times broadcasting a tx has been retried.
+	retries *lru.Cache[common.Hash, int]
+	// broadcast is the set of txs that have passed CheckTx, i.e. have been broadcast to the
+	// CometBFT mempool, and have not been ejected from it since.
+	broadcast *lru.Cache[common.Hash, struct{}]
+	// ejections is the last reason a tx was ejected from the CometBFT mempool.
+	ejections *lru.Cache[common.Hash, string]
+}
+
+// newTxStatusCache creates a new txStatusCache.
+func newTxStatusCache() *txStatusCache {
+	return &txStatusCache{
+		retries:   lru.NewCache[common.Hash, int](defaultCacheSize),
+		broadcast: lru.NewCache[common.Hash, struct{}](defaultCacheSize),
+		ejections: lru.NewCache[common.Hash, string](defaultCacheSize),
+	}
+}
+
+// MarkBroadcastRetry records that broadcasting the tx has been retried once more.
+func (tsc *txStatusCache) MarkBroadcastRetry(txHash common.Hash) {
+	retries, _ := tsc.retries.Get(txHash)
+	tsc.retries.Add(txHash, retries+1)
+}
+
+// MarkBroadcast records that the tx has passed CheckTx and so been broadcast to the CometBFT
+// mempool.
+func (tsc *txStatusCache) MarkBroadcast(txHash common.Hash) {
+	tsc.broadcast.Add(txHash, struct{}{})
+}
+
+// MarkEjected records that the tx has been ejected from the CometBFT mempool and why.
+func (tsc *txStatusCache) MarkEjected(txHash common.Hash, reason error) {
+	tsc.broadcast.Remove(txHash)
+	tsc.ejections.Add(txHash, reason.Error())
+}
+
+// SetHostMempool sets the CometBFT mempool that is queried for the presence of txs, along with
+// the decoder of the txs in it. It must be called before the node starts.
+func (m *Mempool) SetHostMempool(hostMempool HostMempool, txDecoder sdk.TxDecoder) {
+	m.hostMempool = hostMempool
+	m.txDecoder = txDecoder
+}
+
+// TxStatus implements polar.TxStatusProvider. It returns the CometBFT side view of the tx with
+// the given hash, as recorded by the mempool and as reported by the CometBFT mempool itself.
+func (m *Mempool) TxStatus(txHash common.Hash) *polarapi.HostTxStatus {
+	status := &polarapi.HostTxStatus{
+		RemoteSeen:      m.crc.IsRemoteTx(txHash),
+		RemoteSeenAt:    m.crc.TimeFirstSeen(txHash),
+		BroadcastToHost: m.tsc.broadcast.Contains(txHash),
+		InHostMempool:   m.inHostMempool(txHash),
+	}
+	status.BroadcastRetries, _ = m.tsc.retries.Get(txHash)
+	status.EjectionReason, _ = m.tsc.ejections.Get(txHash)
+
+	// CometBFT removes txs from its mempool once they are included in a block.
+	if status.BroadcastToHost && m.chain.GetTransactionLookup(txHash) != nil {
+		status.BroadcastToHost = false
+	}
+	return status
+}
+
+// inHostMempool queries the CometBFT mempool for the tx with the given hash. It returns nil if
+// the presence of the tx cannot be determined, i.e. if no CometBFT mempool is set, it cannot be
+// queried or it holds more txs than a single query returns and the tx is not among them.
+func (m *Mempool) inHostMempool(txHash common.Hash) *bool {
+	if m.hostMempool == nil {
+		return nil
+	}
+
+	limit := maxHostMempoolTxs
+	res, err := m.hostMempool.UnconfirmedTxs(context.Background(), &limit)
+	if err != nil {
+		return nil
+	}
+
+	for _, bz := range res.Txs {
+		sdkTx, decodeErr := m.txDecoder(bz)
+		if decodeErr != nil {
+			continue
+		}
+		msgs := sdkTx.GetMsgs()
+		if len(msgs) != 1 {
+			continue
+		}
+		wet, ok := utils.GetAs[*types.WrappedEthereumTransaction](msgs[0])
+		if !ok {
+			continue
+		}
+		if ethTx := wet.Unwrap(); ethTx != nil && ethTx.Hash() == txHash {
+			found := true
+			return &found
+		}
+	}
+
+	// The tx is only known to be absent if every tx in the mempool has been checked.
+	if res.Count < res.Total {
+		return nil
+	}
+	found := false
+	return &found
+}
@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package txpool
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/berachain/polaris/cosmos/runtime/txpool/mocks"
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
+	"github.com/berachain/polaris/eth/core"
+	"github.com/berachain/polaris/eth/core/types"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// includedChain is a core.ChainReader that only knows which txs are included.
+type includedChain struct {
+	core.ChainReader
+	included map[common.Hash]struct{}
+}
+
+func (c *includedChain) GetTransactionLookup(hash common.Hash) *types.TxLookupEntry {
+	if _, found := c.included[hash]; found {
+		return &types.TxLookupEntry{}
+	}
+	return nil
+}
+
+// hostMempool is a HostMempool whose first limit txs are returned, out of all of its txs.
+type hostMempool struct {
+	txs cmttypes.Txs
+	err error
+}
+
+func (hm *hostMempool) UnconfirmedTxs(
+	_ context.Context, limit *int,
+) (*coretypes.ResultUnconfirmedTxs, error) {
+	txs := hm.txs
+	if len(txs) > *limit {
+		txs = txs[:*limit]
+	}
+	return &coretypes.ResultUnconfirmedTxs{Count: len(txs), Total: len(hm.txs), Txs: txs}, hm.err
+}
+
+// decodeEthTx decodes the bytes of an eth tx into an sdk tx that wraps it.
+func decodeEthTx(bz []byte) (sdk.Tx, error) {
+	ethTx := new(ethtypes.Transaction)
+	if err := ethTx.UnmarshalBinary(bz); err != nil {
+		return nil, err
+	}
+	wet, err := evmtypes.WrapTx(ethTx)
+	if err != nil {
+		return nil, err
+	}
+	sdkTx := mocks.NewSdkTx(GinkgoT())
+	sdkTx.On("GetMsgs").Return([]sdk.Msg{wet})
+	return sdkTx, nil
+}
+
+// hostTxs returns the txs in the CometBFT mempool that wrap the given eth txs.
+func hostTxs(ethTxs ...*ethtypes.Transaction) cmttypes.Txs {
+	txs := make(cmttypes.Txs, len(ethTxs))
+	for i, ethTx := range ethTxs {
+		bz, err := ethTx.MarshalBinary()
+		Expect(err).ToNot(HaveOccurred())
+		txs[i] = bz
+	}
+	return txs
+}
+
+var _ = Describe("TxStatus", func() {
+	var (
+		m     *Mempool
+		chain *includedChain
+		tx    *ethtypes.Transaction
+	)
+
+	BeforeEach(func() {
+		chain = &includedChain{included: make(map[common.Hash]struct{})}
+		m = New(chain, nil, 100, &sync.RWMutex{}, big.NewInt(1))
+		tx = ethtypes.NewTx(&ethtypes.LegacyTx{GasPrice: big.NewInt(2)})
+	})
+
+	It("should report an unknown tx", func() {
+		status := m.TxStatus(tx.Hash())
+		Expect(status.RemoteSeen).To(BeFalse())
+		Expect(status.BroadcastToHost).To(BeFalse())
+		Expect(status.BroadcastRetries).To(BeZero())
+		Expect(status.EjectionReason).To(BeEmpty())
+		Expect(status.InHostMempool).To(BeNil())
+	})
+
+	It("should report whether the comet mempool holds a tx", func() {
+		other := ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(2)})
+		hm := &hostMempool{txs: hostTxs(other)}
+		m.SetHostMempool(hm, decodeEthTx)
+		Expect(*m.TxStatus(tx.Hash()).InHostMempool).To(BeFalse())
+
+		// The comet mempool is queried, regardless of what was broadcast.
+		hm.txs = append(hm.txs, hostTxs(tx)...)
+		Expect(*m.TxStatus(tx.Hash()).InHostMempool).To(BeTrue())
+		Expect(m.TxStatus(tx.Hash()).BroadcastToHost).To(BeFalse())
+
+		// Txs that cannot be decoded are skipped.
+		hm.txs = append(cmttypes.Txs{[]byte("not a tx")}, hm.txs...)
+		Expect(*m.TxStatus(tx.Hash()).InHostMempool).To(BeTrue())
+	})
+
+	It("should not report absence beyond the txs returned by the comet mempool", func() {
+		hm := &hostMempool{}
+		for i := 0; i < maxHostMempoolTxs; i++ {
+			hm.txs = append(hm.txs, hostTxs(ethtypes.NewTx(&ethtypes.LegacyTx{
+				Nonce: uint64(i) + 1, GasPrice: big.NewInt(2),
+			}))...)
+		}
+		m.SetHostMempool(hm, decodeEthTx)
+		Expect(*m.TxStatus(tx.Hash()).InHostMempool).To(BeFalse())
+
+		// The tx is beyond the txs returned, so its presence is unknown.
+		hm.txs = append(hm.txs, hostTxs(tx)...)
+		Expect(m.TxStatus(tx.Hash()).InHostMempool).To(BeNil())
+
+		hm.err = errors.New("comet is unavailable")
+		Expect(m.TxStatus(tx.Hash()).InHostMempool).To(BeNil())
+	})
+
+	It("should report remote, retried and comet mempool txs", func() {
+		m.crc.MarkRemoteSeen(tx.Hash())
+		m.tsc.MarkBroadcast(tx.Hash())
+		m.tsc.MarkBroadcastRetry(tx.Hash())
+		m.tsc.MarkBroadcastRetry(tx.Hash())
+
+		status := m.TxStatus(tx.Hash())
+		Expect(status.RemoteSeen).To(BeTrue())
+		Expect(status.RemoteSeenAt).ToNot(BeZero())
+		Expect(status.BroadcastToHost).To(BeTrue())
+		Expect(status.BroadcastRetries).To(Equal(2))
+
+		// Included txs are no longer in the comet mempool.
+		chain.included[tx.Hash()] = struct{}{}
+		Expect(m.TxStatus(tx.Hash()).BroadcastToHost).To(BeFalse())
+	})
+
+	It("should report the reason a tx should be ejected", func() {
+		m.crc.MarkRemoteSeen(tx.Hash())
+		now := m.crc.TimeFirstSeen(tx.Hash())
+		Expect(m.shouldEjectFromCometMempool(now, tx)).To(Succeed())
+		Expect(m.shouldEjectFromCometMempool(now+101, tx)).To(MatchError(ErrTxExpired))
+
+		underpriced := ethtypes.NewTx(&ethtypes.LegacyTx{GasPrice: big.NewInt(1)})
+		m.crc.MarkRemoteSeen(underpriced.Hash())
+		Expect(m.shouldEjectFromCometMempool(now, underpriced)).To(MatchError(ErrTxUnderpriced))
+
+		chain.included[tx.Hash()] = struct{}{}
+		Expect(m.shouldEjectFromCometMempool(now, tx)).To(MatchError(ErrTxIncluded))
+	})
+
+	It("should record the last ejection reason", func() {
+		m.tsc.MarkBroadcast(tx.Hash())
+		m.tsc.MarkEjected(tx.Hash(), ErrTxExpired)
+
+		status := m.TxStatus(tx.Hash())
+		Expect(status.BroadcastToHost).To(BeFalse())
+		Expect(status.EjectionReason).To(Equal(ErrTxExpired.Error()))
+	})
+})
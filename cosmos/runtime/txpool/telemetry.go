@@ -35,6 +35,10 @@ const (
 	MetricKeyAnteShouldEjectInclusion  = "polaris_cometbft_ante_should_eject_included"
 	MetricKeyAnteShouldEjectExpiredTx  = "polaris_cometbft_ante_should_eject_expired"
 	MetricKeyAnteShouldEjectPriceLimit = "polaris_cometbft_ante_should_eject_price_limit"
+	MetricKeyAnteShouldEjectPolicy     = "polaris_cometbft_ante_should_eject_policy"
+	MetricKeyAnteShouldEjectEvicted    = "polaris_cometbft_ante_should_eject_evicted"
+
+	MetricKeyPolicyRejectedTxs = "polaris_cometbft_policy_rejected_txs"
 
 	MetricKeyTxPoolPending = "polaris_cometbft_txpool_pending"
 	MetricKeyTxPoolQueue   = "polaris_cometbft_txpool_queue"
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// decodedBlock is the EVM data embedded in a Comet block.
+type decodedBlock struct {
+	// Height is the height of the Comet block.
+	Height int64 `json:"height"`
+	// Payload is the execution payload of the EVM block proposed in the Comet block, if any.
+	Payload *engine.ExecutableData `json:"payload,omitempty"`
+	// Transactions are the decoded transactions of the execution payload.
+	Transactions []*ethtypes.Transaction `json:"transactions"`
+	// WrappedTransactions are the Ethereum transactions wrapped in their own Cosmos transaction.
+	WrappedTransactions []*ethtypes.Transaction `json:"wrappedTransactions,omitempty"`
+}
+
+// DecodeBlockCmd returns a command that prints the EVM block and transactions embedded in the
+// Comet block at the given height.
+func DecodeBlockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-block [height]",
+		Short: "Print the EVM block and transactions embedded in a Comet block",
+		Long: `Print, as JSON, the execution payload and transactions decoded from the
+WrappedPayloadEnvelope and WrappedEthereumTransaction messages in the Comet block at the given
+height. Transactions that are not Cosmos transactions, such as vote extensions, are skipped.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+			res, err := node.Block(cmd.Context(), &height)
+			if err != nil {
+				return err
+			}
+
+			decoded := &decodedBlock{
+				Height:       res.Block.Height,
+				Transactions: []*ethtypes.Transaction{},
+			}
+			for _, bz := range res.Block.Txs {
+				sdkTx, decodeErr := clientCtx.TxConfig.TxDecoder()(bz)
+				if decodeErr != nil {
+					continue
+				}
+				for _, msg := range sdkTx.GetMsgs() {
+					if err = decoded.add(msg); err != nil {
+						return err
+					}
+				}
+			}
+
+			out, err := json.MarshalIndent(decoded, "", "  ")
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(out))
+			return err
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// add decodes the EVM data of the given message, if it holds any, into the block.
+func (b *decodedBlock) add(msg any) error {
+	switch msg := msg.(type) {
+	case *types.WrappedPayloadEnvelope:
+		envelope := msg.UnwrapPayload()
+		if envelope == nil || envelope.ExecutionPayload == nil {
+			return errors.New("failed to decode the payload envelope")
+		}
+		b.Payload = envelope.ExecutionPayload
+		for _, txBz := range b.Payload.Transactions {
+			tx := new(ethtypes.Transaction)
+			if err := tx.UnmarshalBinary(txBz); err != nil {
+				return err
+			}
+			b.Transactions = append(b.Transactions, tx)
+		}
+	case *types.WrappedEthereumTransaction:
+		tx := msg.Unwrap()
+		if tx == nil {
+			return errors.New("failed to decode the wrapped ethereum transaction")
+		}
+		b.WrappedTransactions = append(b.WrappedTransactions, tx)
+	}
+	return nil
+}
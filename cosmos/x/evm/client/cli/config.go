@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/berachain/polaris/cosmos/config"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// ValidateConfigCmd returns a command that validates the Polaris section of app.toml and
+// prints the values that differ from the defaults.
+func ValidateConfigCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the Polaris configuration in app.toml",
+		Long: `Validate the [polaris] section of app.toml, reporting every invalid field, and
+print the values that differ from the defaults. The same validation runs when the node
+starts.`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := config.ReadConfigFromAppOpts(server.GetServerContextFromCmd(cmd).Viper)
+			if err != nil {
+				problems := []error{err}
+				if joined, ok := err.(interface{ Unwrap() []error }); ok { //nolint:errorlint // joined.
+					problems = joined.Unwrap()
+				}
+				for _, problem := range problems {
+					cmd.PrintErrln(problem)
+				}
+				return fmt.Errorf("found %d problem(s) in the polaris config", len(problems))
+			}
+
+			cmd.Println("polaris config is valid")
+			for _, diff := range config.DiffFromDefaults(cfg) {
+				cmd.Printf("%s = %s (default %s)\n", diff.Field, diff.Value, diff.Default)
+			}
+			return nil
+		},
+	}
+}
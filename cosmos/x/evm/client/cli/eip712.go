@@ -0,0 +1,280 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/spf13/cobra"
+
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	polarkeyring "github.com/berachain/polaris/cosmos/crypto/keyring"
+	"github.com/berachain/polaris/cosmos/crypto/keys/ethsecp256k1"
+	signinglib "github.com/berachain/polaris/cosmos/lib/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+const (
+	flagSignature = "signature"
+	flagLedger    = "ledger"
+)
+
+// eip712Command returns the `evm eip712` command.
+func eip712Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "eip712 [tx-file]",
+		Short: "Print the EIP-712 typed data of a Cosmos transaction",
+		Long: `Print the EIP-712 typed data of an unsigned Cosmos transaction (e.g. generated with
+--generate-only) for the signer given by --from, to be signed by an Ethereum wallet with
+eth_signTypedData_v4. Once signed, run the command again with --signature to add the signature
+to the transaction with the EIP-712 sign mode and print the signed transaction. With --ledger,
+the typed data is signed by the Ledger key of the signer in the keyring instead. The account
+number and sequence are queried from --node, and the EVM chain ID from --evm-rpc, when not set.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			stdTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			adaptableTx, ok := stdTx.(authsigning.V2AdaptableTx)
+			if !ok {
+				return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", stdTx)
+			}
+
+			signerData, err := eip712SignerData(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			chainID, err := eip712ChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			handler := signinglib.NewEIP712SignModeHandler(signinglib.StaticChainID(chainID))
+			sig, _ := cmd.Flags().GetString(flagSignature)
+			if useLedger, _ := cmd.Flags().GetBool(flagLedger); sig != "" || useLedger {
+				return addEIP712Signature(cmd, clientCtx, handler, stdTx, signerData)
+			}
+
+			typedData, err := handler.GetTypedData(
+				cmd.Context(), signerData, adaptableTx.GetSigningTxData(),
+			)
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(typedData, "", "  ")
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+	}
+
+	cmd.Flags().String(flags.FlagFrom, "", "Address of the signer")
+	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	cmd.Flags().Uint64(flags.FlagAccountNumber, 0,
+		"Account number of the signer, queried from --node if not set")
+	cmd.Flags().Uint64(flags.FlagSequence, 0,
+		"Sequence of the signer, queried from --node if not set")
+	cmd.Flags().String(flagEVMRPC, defaultEVMRPC, "Ethereum JSON-RPC endpoint")
+	cmd.Flags().Uint64(flagEthChainID, 0, "EVM chain ID, fetched from --evm-rpc if not set")
+	cmd.Flags().String(flagSignature, "", "Hex encoded signature of the typed data to add")
+	cmd.Flags().Bool(flagLedger, false, "Sign the typed data with the Ledger key of the signer")
+	flags.AddQueryFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+	cmd.MarkFlagsMutuallyExclusive(flagSignature, flagLedger)
+	return cmd
+}
+
+// addEIP712Signature adds the signature of the typed data of the transaction, made by the signer
+// of the given data, to the transaction and prints it. The signature is given by --signature or
+// made on a Ledger with --ledger.
+func addEIP712Signature(
+	cmd *cobra.Command, clientCtx client.Context, handler *signinglib.EIP712SignModeHandler,
+	stdTx sdk.Tx, signerData txsigning.SignerData,
+) error {
+	txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(stdTx)
+	if err != nil {
+		return err
+	}
+	signBytes, err := handler.GetSignBytes(
+		cmd.Context(), signerData,
+		txBuilder.GetTx().(authsigning.V2AdaptableTx).GetSigningTxData(),
+	)
+	if err != nil {
+		return err
+	}
+	sig, err := eip712Signature(cmd, clientCtx, signerData, signBytes)
+	if err != nil {
+		return err
+	}
+
+	// Wallets return the signature with a V of 27 or 28, so normalize it to recover the key.
+	recoverySig := make([]byte, len(sig))
+	copy(recoverySig, sig)
+	if recoverySig[ethcrypto.RecoveryIDOffset] >= 27 { //nolint:gomnd // legacy V offset.
+		recoverySig[ethcrypto.RecoveryIDOffset] -= 27
+	}
+	pubKey, err := ethcrypto.SigToPub(ethcrypto.Keccak256(signBytes), recoverySig)
+	if err != nil {
+		return err
+	}
+	if signer := sdk.AccAddress(ethcrypto.PubkeyToAddress(*pubKey).Bytes()); signer.String() !=
+		signerData.Address {
+		return fmt.Errorf("signature is by %s, not by the signer %s", signer, signerData.Address)
+	}
+
+	if err = txBuilder.SetSignatures(signingtypes.SignatureV2{
+		PubKey: &ethsecp256k1.PubKey{Key: ethcrypto.CompressPubkey(pubKey)},
+		Data: &signingtypes.SingleSignatureData{
+			SignMode:  signinglib.TxSignModeEIP712,
+			Signature: recoverySig,
+		},
+		Sequence: signerData.Sequence,
+	}); err != nil {
+		return err
+	}
+	bz, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+	return err
+}
+
+// eip712Signature returns the signature given by --signature, or signs the given sign bytes with
+// the Ledger key of the signer in the keyring if --ledger is set.
+func eip712Signature(
+	cmd *cobra.Command, clientCtx client.Context, signerData txsigning.SignerData,
+	signBytes []byte,
+) ([]byte, error) {
+	if useLedger, _ := cmd.Flags().GetBool(flagLedger); useLedger {
+		return signEIP712WithLedger(clientCtx, signerData.Address, signBytes)
+	}
+
+	sigHex, _ := cmd.Flags().GetString(flagSignature)
+	sig, err := hexutil.Decode(sigHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", flagSignature, err)
+	}
+	if len(sig) != ethcrypto.SignatureLength {
+		return nil, fmt.Errorf("invalid --%s: expected %d bytes, got %d", flagSignature,
+			ethcrypto.SignatureLength, len(sig))
+	}
+	return sig, nil
+}
+
+// signEIP712WithLedger signs the given EIP-712 sign bytes on the Ledger holding the key of the
+// given signer in the keyring, after checking that the device derives the key of the signer.
+func signEIP712WithLedger(
+	clientCtx client.Context, signer string, signBytes []byte,
+) ([]byte, error) {
+	if clientCtx.Keyring == nil {
+		return nil, errors.New("no keyring to find the Ledger key of the signer in")
+	}
+	address, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return nil, err
+	}
+	record, err := clientCtx.Keyring.KeyByAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	if record.GetLedger() == nil {
+		return nil, fmt.Errorf("key %s of the signer is not a Ledger key", record.Name)
+	}
+
+	sig, _, err := polarkeyring.NewEIP712Keyring(clientCtx.Keyring).SignByAddress(
+		address, signBytes, signinglib.TxSignModeEIP712,
+	)
+	return sig, err
+}
+
+// eip712SignerData returns the data of the signer given by the flags of the command, querying
+// the account number and sequence from the node when not set.
+func eip712SignerData(cmd *cobra.Command, clientCtx client.Context) (txsigning.SignerData, error) {
+	fs := cmd.Flags()
+	from, _ := fs.GetString(flags.FlagFrom)
+	address, err := parseAddress(from)
+	if err != nil {
+		return txsigning.SignerData{}, err
+	}
+	signerData := txsigning.SignerData{
+		Address: sdk.AccAddress(address.Bytes()).String(),
+		ChainID: clientCtx.ChainID,
+	}
+
+	if fs.Changed(flags.FlagAccountNumber) && fs.Changed(flags.FlagSequence) {
+		signerData.AccountNumber, _ = fs.GetUint64(flags.FlagAccountNumber)
+		signerData.Sequence, _ = fs.GetUint64(flags.FlagSequence)
+		return signerData, nil
+	}
+	if signerData.AccountNumber, signerData.Sequence, err = clientCtx.AccountRetriever.
+		GetAccountNumberSequence(clientCtx, address.Bytes()); err != nil {
+		return txsigning.SignerData{}, err
+	}
+	if fs.Changed(flags.FlagAccountNumber) {
+		signerData.AccountNumber, _ = fs.GetUint64(flags.FlagAccountNumber)
+	}
+	if fs.Changed(flags.FlagSequence) {
+		signerData.Sequence, _ = fs.GetUint64(flags.FlagSequence)
+	}
+	return signerData, nil
+}
+
+// eip712ChainID returns the EVM chain ID given by the flags of the command, fetching it through
+// JSON-RPC when not set.
+func eip712ChainID(cmd *cobra.Command) (*big.Int, error) {
+	if cmd.Flags().Changed(flagEthChainID) {
+		chainID, _ := cmd.Flags().GetUint64(flagEthChainID)
+		return new(big.Int).SetUint64(chainID), nil
+	}
+
+	rpcURL, _ := cmd.Flags().GetString(flagEVMRPC)
+	ec, err := ethclient.DialContext(cmd.Context(), rpcURL)
+	if err != nil {
+		return nil, err
+	}
+	defer ec.Close()
+	return ec.ChainID(cmd.Context())
+}
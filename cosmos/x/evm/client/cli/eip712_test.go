@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+
+	cryptocodec "github.com/berachain/polaris/cosmos/crypto/codec"
+	"github.com/berachain/polaris/cosmos/crypto/hd"
+	polarkeyring "github.com/berachain/polaris/cosmos/crypto/keyring"
+	signinglib "github.com/berachain/polaris/cosmos/lib/signing"
+	"github.com/berachain/polaris/cosmos/testutil"
+	"github.com/berachain/polaris/cosmos/x/evm/client/cli"
+	"github.com/berachain/polaris/eth/accounts"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("eip712", func() {
+	const evmChainID = 2061
+
+	var (
+		clientCtx client.Context
+		txFile    string
+		from      sdk.AccAddress
+		key       *ecdsa.PrivateKey
+	)
+
+	BeforeEach(func() {
+		encCfg := testutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{})
+		cryptocodec.RegisterInterfaces(encCfg.InterfaceRegistry)
+		txConfig := authtx.NewTxConfig(encCfg.Codec, authtx.DefaultSignModes)
+		clientCtx = client.Context{}.
+			WithTxConfig(txConfig).
+			WithCodec(encCfg.Codec).
+			WithInterfaceRegistry(encCfg.InterfaceRegistry).
+			WithChainID("polaris-2061")
+
+		var err error
+		key, err = crypto.GenerateKey()
+		Expect(err).ToNot(HaveOccurred())
+		from = sdk.AccAddress(crypto.PubkeyToAddress(key.PublicKey).Bytes())
+
+		builder := txConfig.NewTxBuilder()
+		Expect(builder.SetMsgs(banktypes.NewMsgSend(
+			from, sdk.AccAddress(testutil.Bob.Bytes()),
+			sdk.NewCoins(sdk.NewCoin("abera", sdkmath.NewInt(100))),
+		))).To(Succeed())
+		builder.SetGasLimit(100_000)
+		bz, err := txConfig.TxJSONEncoder()(builder.GetTx())
+		Expect(err).ToNot(HaveOccurred())
+		txFile = filepath.Join(GinkgoT().TempDir(), "tx.json")
+		Expect(os.WriteFile(txFile, bz, 0o600)).To(Succeed())
+	})
+
+	// run runs the eip712 command with the given extra arguments and returns its output.
+	run := func(args ...string) (string, error) {
+		cmd := cli.EVMCommand()
+		out := new(bytes.Buffer)
+		cmd.SetOut(out)
+		cmd.SetErr(new(bytes.Buffer))
+		cmd.SetArgs(append([]string{
+			"eip712", txFile, "--from", from.String(), "--account-number", "7",
+			"--sequence", "3", "--chain-id", "polaris-2061", "--eth-chain-id", strconv.Itoa(evmChainID),
+		}, args...))
+		err := cmd.ExecuteContext(
+			context.WithValue(context.Background(), client.ClientContextKey, &clientCtx),
+		)
+		return out.String(), err
+	}
+
+	// typedDataHash returns the EIP-712 hash of the typed data printed by the command.
+	typedDataHash := func() []byte {
+		out, err := run()
+		Expect(err).ToNot(HaveOccurred())
+		var typedData apitypes.TypedData
+		Expect(json.Unmarshal([]byte(out), &typedData)).To(Succeed())
+		hash, _, err := apitypes.TypedDataAndHash(typedData)
+		Expect(err).ToNot(HaveOccurred())
+		return hash
+	}
+
+	It("should print the typed data of the transaction to stdout", func() {
+		out, err := run()
+		Expect(err).ToNot(HaveOccurred())
+
+		var typedData apitypes.TypedData
+		Expect(json.Unmarshal([]byte(out), &typedData)).To(Succeed())
+		Expect((*big.Int)(typedData.Domain.ChainId).Int64()).To(Equal(int64(evmChainID)))
+		Expect(typedData.Message["accountNumber"]).To(Equal("7"))
+		Expect(typedData.Message["sequence"]).To(Equal("3"))
+	})
+
+	It("should add a signature of the typed data with the EIP-712 sign mode", func() {
+		sig, err := crypto.Sign(typedDataHash(), key)
+		Expect(err).ToNot(HaveOccurred())
+		sig[crypto.RecoveryIDOffset] += 27 // as returned by wallets
+
+		out, err := run("--signature", hexutil.Encode(sig))
+		Expect(err).ToNot(HaveOccurred())
+		tx, err := clientCtx.TxConfig.TxJSONDecoder()([]byte(out))
+		Expect(err).ToNot(HaveOccurred())
+		sigs, err := tx.(authsigning.Tx).GetSignaturesV2()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sigs).To(HaveLen(1))
+		Expect(sigs[0].Sequence).To(Equal(uint64(3)))
+		Expect(sdk.AccAddress(sigs[0].PubKey.Address())).To(Equal(from))
+		Expect(sigs[0].Data).To(HaveField("SignMode", signinglib.TxSignModeEIP712))
+	})
+
+	It("should reject signatures that are not by the signer", func() {
+		other, err := crypto.GenerateKey()
+		Expect(err).ToNot(HaveOccurred())
+		sig, err := crypto.Sign(typedDataHash(), other)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = run("--signature", hexutil.Encode(sig))
+		Expect(err).To(MatchError(ContainSubstring("not by the signer")))
+	})
+
+	It("should reject signatures of the wrong length", func() {
+		_, err := run("--signature", "0x1234")
+		Expect(err).To(MatchError(ContainSubstring("expected 65 bytes")))
+	})
+
+	It("should only sign with --ledger for Ledger keys in the keyring", func() {
+		_, err := run("--ledger")
+		Expect(err).To(MatchError(ContainSubstring("no keyring")))
+
+		clientCtx.Keyring = keyring.NewInMemory(
+			clientCtx.Codec, polarkeyring.OnlyEthSecp256k1Option(),
+		)
+		_, err = clientCtx.Keyring.NewAccount(
+			"local", testdata.TestMnemonic, "", accounts.BIP44HDPath, hd.EthSecp256k1,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		record, err := clientCtx.Keyring.Key("local")
+		Expect(err).ToNot(HaveOccurred())
+		address, err := record.GetAddress()
+		Expect(err).ToNot(HaveOccurred())
+		from = address
+
+		_, err = run("--ledger")
+		Expect(err).To(MatchError(ContainSubstring("is not a Ledger key")))
+	})
+
+	It("should not accept both --signature and --ledger", func() {
+		_, err := run("--ledger", "--signature", "0x1234")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const flagPrefix = "prefix"
+
+// EVMCommand returns the `evm` command group of key, address and transaction utilities.
+func EVMCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "evm",
+		Short:                      "EVM key, address and transaction utilities",
+		SuggestionsMinimumDistance: 2, //nolint:gomnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		addrCommand(),
+		keysCommand(),
+		txCommand(),
+		eip712Command(),
+		DecodeBlockCmd(),
+	)
+	return cmd
+}
+
+// addrCommand returns the `evm addr` command group.
+func addrCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "addr",
+		Short: "Address utilities",
+		RunE:  client.ValidateCmd,
+	}
+
+	convertCmd := &cobra.Command{
+		Use:   "convert [address]",
+		Short: "Convert an address between its bech32 and 0x hex forms",
+		Long: `Convert an address between its bech32 and 0x hex forms. The bech32 address may have
+any prefix, and is printed with the account prefix of the chain unless --prefix is set.`,
+		Example: "polard evm addr convert 0x20f33CE90A13a4b5E7697E3544c3083B8F8A51D4",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := parseAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			prefix, err := cmd.Flags().GetString(flagPrefix)
+			if err != nil {
+				return err
+			}
+			bech32Address, err := bech32.ConvertAndEncode(prefix, address.Bytes())
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(
+				cmd.OutOrStdout(), "bech32: %s\nhex:    %s\n", bech32Address, address.Hex(),
+			)
+			return err
+		},
+	}
+	convertCmd.Flags().String(
+		flagPrefix, sdk.GetConfig().GetBech32AccountAddrPrefix(), "Bech32 prefix of the output",
+	)
+
+	cmd.AddCommand(convertCmd)
+	return cmd
+}
+
+// parseAddress parses an address given in its 0x hex form or in its bech32 form with any prefix.
+func parseAddress(s string) (common.Address, error) {
+	if common.IsHexAddress(s) {
+		return common.HexToAddress(s), nil
+	}
+
+	_, bz, err := bech32.DecodeAndConvert(s)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid address %s: %w", s, err)
+	}
+	if len(bz) != common.AddressLength {
+		return common.Address{}, fmt.Errorf(
+			"invalid address %s: expected %d bytes, got %d", s, common.AddressLength, len(bz),
+		)
+	}
+	return common.BytesToAddress(bz), nil
+}
@@ -0,0 +1,222 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+
+	cryptocodec "github.com/berachain/polaris/cosmos/crypto/codec"
+	"github.com/berachain/polaris/cosmos/crypto/hd"
+	polarkeyring "github.com/berachain/polaris/cosmos/crypto/keyring"
+	"github.com/berachain/polaris/cosmos/testutil"
+	"github.com/berachain/polaris/cosmos/x/evm/client/cli"
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+	"github.com/berachain/polaris/eth/accounts"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EVM Command", func() {
+	It("should print its output to stdout", func() {
+		cmd := cli.EVMCommand()
+		out, errOut := new(bytes.Buffer), new(bytes.Buffer)
+		cmd.SetOut(out)
+		cmd.SetErr(errOut)
+		cmd.SetArgs([]string{
+			"addr", "convert", "0x20f33CE90A13a4b5E7697E3544c3083B8F8A51D4", "--prefix", "polar",
+		})
+		Expect(cmd.Execute()).To(Succeed())
+
+		Expect(out.String()).To(ContainSubstring("hex:    0x20f33CE90A13a4b5E7697E3544c3083B8F8A51D4"))
+		Expect(out.String()).To(ContainSubstring("bech32: polar1"))
+		Expect(errOut.String()).To(BeEmpty())
+	})
+})
+
+var _ = Describe("EVM Keys and Transactions", func() {
+	const (
+		evmChainID = 2061
+		passphrase = "polaris-passphrase"
+	)
+
+	var (
+		clientCtx client.Context
+		from      common.Address
+	)
+
+	// newKeyring returns an in-memory keyring of eth_secp256k1 keys.
+	newKeyring := func() keyring.Keyring {
+		return keyring.NewInMemory(clientCtx.Codec, polarkeyring.OnlyEthSecp256k1Option())
+	}
+
+	BeforeEach(func() {
+		encCfg := testutil.MakeTestEncodingConfig(auth.AppModuleBasic{})
+		cryptocodec.RegisterInterfaces(encCfg.InterfaceRegistry)
+		clientCtx = client.Context{}.
+			WithCodec(encCfg.Codec).
+			WithInterfaceRegistry(encCfg.InterfaceRegistry)
+		clientCtx = clientCtx.WithKeyring(newKeyring())
+
+		record, err := clientCtx.Keyring.NewAccount(
+			"local", testdata.TestMnemonic, "", accounts.BIP44HDPath, hd.EthSecp256k1,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		pubKey, err := record.GetPubKey()
+		Expect(err).ToNot(HaveOccurred())
+		from = common.BytesToAddress(pubKey.Address())
+	})
+
+	// run runs the evm command with the given arguments and input and returns its output.
+	run := func(input string, args ...string) (string, error) {
+		ctx := clientCtx.WithInput(strings.NewReader(input))
+		cmd := cli.EVMCommand()
+		out := new(bytes.Buffer)
+		cmd.SetOut(out)
+		cmd.SetErr(new(bytes.Buffer))
+		cmd.SetArgs(args)
+		err := cmd.ExecuteContext(
+			context.WithValue(context.Background(), client.ClientContextKey, &ctx),
+		)
+		return out.String(), err
+	}
+
+	It("should export and import keys through geth keystore files", func() {
+		keyFile := filepath.Join(GinkgoT().TempDir(), "key.json")
+		_, err := run(passphrase+"\n"+passphrase+"\n",
+			"keys", "export-eth", "local", "--output-file", keyFile)
+		Expect(err).ToNot(HaveOccurred())
+		keyJSON, err := os.ReadFile(keyFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(strings.ToLower(string(keyJSON))).
+			To(ContainSubstring(strings.ToLower(from.Hex()[2:])))
+
+		exported := clientCtx.Keyring
+		clientCtx = clientCtx.WithKeyring(newKeyring())
+		_, err = run("wrong-passphrase\n", "keys", "import-eth", "imported", keyFile)
+		Expect(err).To(HaveOccurred())
+
+		out, err := run(passphrase+"\n", "keys", "import-eth", "imported", keyFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(Equal("imported " + from.Hex() + " as imported\n"))
+
+		local, err := exported.Key("local")
+		Expect(err).ToNot(HaveOccurred())
+		imported, err := clientCtx.Keyring.Key("imported")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(imported.PubKey).To(Equal(local.PubKey))
+		Expect(imported.GetLocal().PrivKey).To(Equal(local.GetLocal().PrivKey))
+	})
+
+	It("should not export keys with mismatched passphrases", func() {
+		_, err := run(passphrase+"\nanother-passphrase\n", "keys", "export-eth", "local")
+		Expect(err).To(MatchError("passphrases do not match"))
+	})
+
+	It("should sign transactions offline with the fields that are set", func() {
+		to := common.Address{0x1}
+		out, err := run("", "tx", "sign", "--from", "local", "--to", to.Hex(), "--value", "10",
+			"--eth-chain-id", "2061", "--nonce", "5", "--gas", "21000", "--gas-fee-cap", "100",
+			"--gas-tip-cap", "2", "--evm-rpc", "http://127.0.0.1:0",
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		tx := new(ethtypes.Transaction)
+		Expect(tx.UnmarshalBinary(hexutil.MustDecode(strings.TrimSpace(out)))).To(Succeed())
+		Expect(tx.ChainId().Int64()).To(Equal(int64(evmChainID)))
+		Expect(tx.Nonce()).To(Equal(uint64(5)))
+		Expect(tx.Gas()).To(Equal(uint64(21000)))
+		Expect(tx.GasFeeCap().Int64()).To(Equal(int64(100)))
+		Expect(tx.GasTipCap().Int64()).To(Equal(int64(2)))
+		Expect(tx.To()).To(Equal(&to))
+		Expect(tx.Value().Int64()).To(Equal(int64(10)))
+
+		sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sender).To(Equal(from))
+	})
+})
+
+var _ = Describe("Decode Block", func() {
+	var txs ethtypes.Transactions
+
+	BeforeEach(func() {
+		key, err := crypto.GenerateKey()
+		Expect(err).ToNot(HaveOccurred())
+		signer := ethtypes.LatestSignerForChainID(big.NewInt(2061))
+		txs = nil
+		for nonce := uint64(0); nonce < 2; nonce++ {
+			txs = append(txs, ethtypes.MustSignNewTx(key, signer, &ethtypes.DynamicFeeTx{
+				ChainID: big.NewInt(2061), Nonce: nonce, Gas: 21000, To: &common.Address{0x1},
+			}))
+		}
+	})
+
+	It("should decode the payload envelope and the wrapped transactions", func() {
+		// The payload must have a base fee to decode.
+		block := ethtypes.NewBlock(
+			&ethtypes.Header{Number: big.NewInt(3), BaseFee: big.NewInt(1)},
+			txs[:1], nil, nil, trie.NewStackTrie(nil),
+		)
+		payload, err := types.WrapPayload(engine.BlockToExecutableData(block, big.NewInt(0), nil))
+		Expect(err).ToNot(HaveOccurred())
+		wrappedTx, err := types.WrapTx(txs[1])
+		Expect(err).ToNot(HaveOccurred())
+
+		decoded, err := cli.DecodeBlockMsgs(payload, wrappedTx, &banktypes.MsgSend{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decoded.Payload.BlockHash).To(Equal(block.Hash()))
+		Expect(decoded.Payload.Number).To(Equal(uint64(3)))
+		Expect(decoded.Transactions).To(HaveLen(1))
+		Expect(decoded.Transactions[0].Hash()).To(Equal(txs[0].Hash()))
+		Expect(decoded.WrappedTransactions).To(HaveLen(1))
+		Expect(decoded.WrappedTransactions[0].Hash()).To(Equal(txs[1].Hash()))
+	})
+
+	It("should not decode malformed messages", func() {
+		_, err := cli.DecodeBlockMsgs(&types.WrappedPayloadEnvelope{Data: []byte("{}")})
+		Expect(err).To(MatchError("failed to decode the payload envelope"))
+
+		_, err = cli.DecodeBlockMsgs(&types.WrappedEthereumTransaction{Data: []byte{0x1}})
+		Expect(err).To(MatchError("failed to decode the wrapped ethereum transaction"))
+	})
+})
@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+	"github.com/berachain/polaris/eth/core"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ValidateGenesisCmd returns a command that validates the evm module's genesis state, including
+// its consistency with the auth module's genesis accounts, and reports all problems at once.
+func ValidateGenesisCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate-evm [file]",
+		Short: "Validate the evm genesis state in the genesis file at the default or given location",
+		Long: `Validate the evm genesis state in the genesis file at the default or given location:
+the chain config fork ordering, the gas limit and base fee bounds, the alloc accounts and the
+consistency of their nonces with the auth module's genesis accounts. All the problems found are
+reported at once.`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			genesisFile := serverCtx.Config.GenesisFile()
+			if len(args) == 1 {
+				genesisFile = args[0]
+			}
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(genesisFile)
+			if err != nil {
+				return err
+			}
+			var appState map[string]json.RawMessage
+			if err = json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+				return fmt.Errorf("failed to unmarshal app state: %w", err)
+			}
+
+			authNonces, err := authGenesisNonces(client.GetClientContextFromCmd(cmd), appState)
+			if err != nil {
+				return err
+			}
+
+			if err = validateGenesis(
+				appState[types.ModuleName], filepath.Join(serverCtx.Config.RootDir, "config"),
+				authNonces,
+			); err != nil {
+				problems := []error{err}
+				if joined, ok := err.(interface{ Unwrap() []error }); ok { //nolint:errorlint // join.
+					problems = joined.Unwrap()
+				}
+				for _, problem := range problems {
+					cmd.PrintErrln(problem)
+				}
+				return fmt.Errorf(
+					"evm genesis state in %s is invalid: found %d problem(s)",
+					genesisFile, len(problems),
+				)
+			}
+			cmd.Printf("evm genesis state in %s is valid\n", genesisFile)
+			return nil
+		},
+	}
+}
+
+// GenesisStreamExporter writes the evm genesis state of the app in the given database, at the
+// given height, to w as a streamed genesis and returns the hash of its content. It is implemented
+// by the app, which owns the keeper.
+type GenesisStreamExporter func(
+	logger log.Logger, db dbm.DB, height int64, appOpts servertypes.AppOptions, w io.Writer,
+) (string, error)
+
+// ExportGenesisStreamCmd returns a command that exports the evm genesis state as a streamed
+// genesis, which is written incrementally instead of being held in memory.
+func ExportGenesisStreamCmd(exporter GenesisStreamExporter) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-evm [file]",
+		Short: "Export the evm genesis state to a streamed genesis file",
+		Long: `Export the evm genesis state to a streamed genesis file, written one account at a
+time in address order. The node must be stopped. An existing file is not overwritten.
+
+To start a chain from the exported state, set the evm module's genesis state to the printed
+{"stream": "<path to the file>", "hash": "<sha256 of the file>"}. A relative path is relative to
+the config directory of the node home.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			height, err := cmd.Flags().GetInt64(server.FlagHeight)
+			if err != nil {
+				return err
+			}
+
+			appDB, err := dbm.NewDB(
+				"application", server.GetAppDBBackend(serverCtx.Viper),
+				filepath.Join(serverCtx.Config.RootDir, "data"),
+			)
+			if err != nil {
+				return err
+			}
+			defer appDB.Close()
+
+			f, err := types.CreateGenesisStream(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			hash, err := exporter(serverCtx.Logger, appDB, height, serverCtx.Viper, f)
+			if err != nil {
+				return err
+			}
+			bz, err := json.Marshal(&types.GenesisStreamRef{Stream: args[0], Hash: hash})
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+	}
+	cmd.Flags().Int64(
+		server.FlagHeight, 0, "Export the state at this height, or at the latest height if 0",
+	)
+	return cmd
+}
+
+// AddGenesisStreamFlag adds the flag to stream the evm genesis state to a separate file to the
+// given export command, whose exported genesis then references the file instead of embedding the
+// state.
+func AddGenesisStreamFlag(exportCmd *cobra.Command) {
+	exportCmd.Flags().String(
+		types.FlagGenesisStream, "",
+		"Stream the evm genesis state to this new file, which must be placed next to the "+
+			"exported genesis.json to import it",
+	)
+}
+
+// validateGenesis validates the given evm genesis state, which may reference a streamed genesis
+// relative to the given config directory.
+func validateGenesis(
+	bz json.RawMessage, configDir string, authNonces map[common.Address]uint64,
+) error {
+	if ref, ok := types.GenesisStreamRefFrom(bz); ok {
+		f, err := ref.Open(configDir)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		return types.ValidateGenesisStream(f, authNonces)
+	}
+
+	ethGen := new(core.Genesis)
+	if err := ethGen.UnmarshalJSON(bz); err != nil {
+		return fmt.Errorf("failed to unmarshal evm genesis state: %w", err)
+	}
+	return types.ValidateGenesis(ethGen, authNonces)
+}
+
+// authGenesisNonces returns the sequences of the auth module's genesis accounts, keyed by their
+// EVM address.
+func authGenesisNonces(
+	clientCtx client.Context, appState map[string]json.RawMessage,
+) (map[common.Address]uint64, error) {
+	authGenState := authtypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+	accounts, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack auth genesis accounts: %w", err)
+	}
+
+	nonces := make(map[common.Address]uint64, len(accounts))
+	for _, account := range accounts {
+		nonces[common.BytesToAddress(account.GetAddress())] = account.GetSequence()
+	}
+	return nonces, nil
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cli
+
+import (
+	"errors"
+	"path/filepath"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/berachain/polaris/cosmos/config"
+	"github.com/berachain/polaris/cosmos/x/evm/plugins/historical"
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// MigrateHistoricalDBCmd returns a command that copies the historical blocks, receipts and tx
+// lookups in the consensus store into the configured off-chain database.
+func MigrateHistoricalDBCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "migrate-historical-db",
+		Short: "Copy historical EVM data from the consensus store into the off-chain database",
+		Long: `Copy the historical EVM blocks, receipts and transaction lookups stored in the
+consensus store at the latest height into the off-chain database configured under
+[polaris.polar.historical-db]. The node must be stopped.
+
+The consensus store is left untouched, as removing the data changes the app hash. It is
+removed in consensus by the evm keeper's MigrateHistoricalData, which chains call from an
+upgrade handler.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			cfg, err := config.ReadConfigFromAppOpts(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+
+			if cfg.Polar.HistoricalDB.Datadir == "" {
+				return errors.New("historical-db datadir must be set")
+			}
+			offChainDB, err := historical.OpenDatabase(cfg.Polar.HistoricalDB)
+			if err != nil {
+				return err
+			}
+			defer offChainDB.Close()
+
+			appDB, err := dbm.NewDB(
+				"application", server.GetAppDBBackend(serverCtx.Viper),
+				filepath.Join(serverCtx.Config.RootDir, "data"),
+			)
+			if err != nil {
+				return err
+			}
+			defer appDB.Close()
+
+			// Only the evm store is loaded, as it is the only one holding historical data.
+			cms := rootmulti.NewStore(appDB, log.NewNopLogger(), metrics.NewNoOpMetrics())
+			storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+			cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+			if err = cms.LoadLatestVersion(); err != nil {
+				return err
+			}
+
+			migrated, err := historical.Migrate(cms.GetKVStore(storeKey), offChainDB, false)
+			if err != nil {
+				return err
+			}
+			cmd.Printf(
+				"migrated %d historical entries at height %d to %s\n",
+				migrated, cms.LastCommitID().Version, cfg.Polar.HistoricalDB.Datadir,
+			)
+			return nil
+		},
+	}
+}
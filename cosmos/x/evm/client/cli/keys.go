@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cli
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/berachain/polaris/cosmos/crypto/hd"
+	"github.com/berachain/polaris/cosmos/crypto/keys/ethsecp256k1"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+const flagOutputFile = "output-file"
+
+// keysCommand returns the `evm keys` command group.
+func keysCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "keys",
+		Short: "Move eth_secp256k1 keys between the keyring and geth keystore files",
+		RunE:  client.ValidateCmd,
+	}
+	cmd.PersistentFlags().String(flags.FlagKeyringDir, "", "The client Keyring directory")
+	cmd.PersistentFlags().String(flags.FlagKeyringBackend, "", "Select keyring's backend")
+
+	cmd.AddCommand(
+		exportEthKeyCmd(),
+		importEthKeyCmd(),
+	)
+	return cmd
+}
+
+// exportEthKeyCmd returns the `evm keys export-eth` command.
+func exportEthKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-eth [name]",
+		Short: "Export an eth_secp256k1 key of the keyring to a geth keystore file",
+		Long: `Export an eth_secp256k1 key of the keyring to a geth keystore file, encrypted with
+a new passphrase. The keystore JSON is printed unless --output-file is set.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			record, err := clientCtx.Keyring.Key(args[0])
+			if err != nil {
+				return err
+			}
+			local := record.GetLocal()
+			if local == nil {
+				return fmt.Errorf("key %s is not stored locally and cannot be exported", args[0])
+			}
+			privKey, ok := local.PrivKey.GetCachedValue().(cryptotypes.PrivKey)
+			if !ok || privKey.Type() != ethsecp256k1.KeyType {
+				return fmt.Errorf("key %s is not an %s key", args[0], ethsecp256k1.KeyType)
+			}
+			ecdsaKey, err := ethcrypto.ToECDSA(privKey.Bytes())
+			if err != nil {
+				return err
+			}
+
+			buf := bufio.NewReader(clientCtx.Input)
+			passphrase, err := input.GetPassword("Enter passphrase to encrypt the keystore:", buf)
+			if err != nil {
+				return err
+			}
+			confirmation, err := input.GetPassword("Repeat the passphrase:", buf)
+			if err != nil {
+				return err
+			}
+			if passphrase != confirmation {
+				return errors.New("passphrases do not match")
+			}
+
+			id, err := uuid.NewRandom()
+			if err != nil {
+				return err
+			}
+			keyJSON, err := keystore.EncryptKey(&keystore.Key{
+				Id:         id,
+				Address:    ethcrypto.PubkeyToAddress(ecdsaKey.PublicKey),
+				PrivateKey: ecdsaKey,
+			}, passphrase, keystore.StandardScryptN, keystore.StandardScryptP)
+			if err != nil {
+				return err
+			}
+
+			outputFile, err := cmd.Flags().GetString(flagOutputFile)
+			if err != nil {
+				return err
+			}
+			if outputFile == "" {
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), string(keyJSON))
+				return err
+			}
+			//nolint:gomnd // owner read/write only, as geth writes keystore files.
+			return os.WriteFile(outputFile, keyJSON, 0o600)
+		},
+	}
+	cmd.Flags().String(flagOutputFile, "", "Write the keystore JSON to this file")
+	return cmd
+}
+
+// importEthKeyCmd returns the `evm keys import-eth` command.
+func importEthKeyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-eth [name] [keystore-file]",
+		Short: "Import a geth keystore file into the keyring as an eth_secp256k1 key",
+		Args:  cobra.ExactArgs(2), //nolint:gomnd // name and file.
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			keyJSON, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+			passphrase, err := input.GetPassword("Enter passphrase to decrypt the keystore:", buf)
+			if err != nil {
+				return err
+			}
+			key, err := keystore.DecryptKey(keyJSON, passphrase)
+			if err != nil {
+				return err
+			}
+
+			if err = clientCtx.Keyring.ImportPrivKeyHex(
+				args[0], hex.EncodeToString(ethcrypto.FromECDSA(key.PrivateKey)),
+				string(hd.EthSecp256k1Type),
+			); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(
+				cmd.OutOrStdout(), "imported %s as %s\n", key.Address.Hex(), args[0],
+			)
+			return err
+		},
+	}
+}
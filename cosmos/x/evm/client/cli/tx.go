@@ -0,0 +1,320 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+const (
+	flagEVMRPC     = "evm-rpc"
+	flagTo         = "to"
+	flagValue      = "value"
+	flagData       = "data"
+	flagNonce      = "nonce"
+	flagGas        = "gas"
+	flagGasFeeCap  = "gas-fee-cap"
+	flagGasTipCap  = "gas-tip-cap"
+	flagEthChainID = "eth-chain-id"
+
+	defaultEVMRPC = "http://localhost:8545"
+)
+
+// txCommand returns the `evm tx` command group.
+func txCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx",
+		Short: "Sign and send Ethereum transactions with keys of the keyring",
+		RunE:  client.ValidateCmd,
+	}
+
+	signCmd := &cobra.Command{
+		Use:   "sign",
+		Short: "Sign an Ethereum transaction and print its raw encoding",
+		Long: `Sign an EIP-1559 Ethereum transaction with the eth_secp256k1 key given by --from and
+print its raw hex encoding. The nonce, gas, fees and chain ID are fetched from the JSON-RPC
+endpoint given by --evm-rpc when not set, so the transaction is signed offline if all are set.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			tx, err := signTx(cmd)
+			if err != nil {
+				return err
+			}
+			bz, err := tx.MarshalBinary()
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), hexutil.Encode(bz))
+			return err
+		},
+	}
+
+	sendCmd := &cobra.Command{
+		Use:   "send",
+		Short: "Sign an Ethereum transaction and send it through JSON-RPC",
+		Long: `Sign an EIP-1559 Ethereum transaction with the eth_secp256k1 key given by --from and
+send it to the JSON-RPC endpoint given by --evm-rpc. The nonce, gas, fees and chain ID are
+fetched from the endpoint when not set.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			tx, err := signTx(cmd)
+			if err != nil {
+				return err
+			}
+			rpcURL, err := cmd.Flags().GetString(flagEVMRPC)
+			if err != nil {
+				return err
+			}
+			ec, err := ethclient.DialContext(cmd.Context(), rpcURL)
+			if err != nil {
+				return err
+			}
+			defer ec.Close()
+
+			if err = ec.SendTransaction(cmd.Context(), tx); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), tx.Hash().Hex())
+			return err
+		},
+	}
+
+	for _, c := range []*cobra.Command{signCmd, sendCmd} {
+		addTxFlags(c)
+	}
+	cmd.AddCommand(signCmd, sendCmd)
+	return cmd
+}
+
+// addTxFlags adds the flags of the fields of the transaction to sign.
+func addTxFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flags.FlagFrom, "", "Name of the keyring key to sign with")
+	cmd.Flags().String(flags.FlagKeyringDir, "", "The client Keyring directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, "", "Select keyring's backend")
+	cmd.Flags().String(flagEVMRPC, defaultEVMRPC, "Ethereum JSON-RPC endpoint")
+	cmd.Flags().String(flagTo, "", "Recipient 0x or bech32 address, or none to create a contract")
+	cmd.Flags().String(flagValue, "0", "Value to transfer, in wei")
+	cmd.Flags().String(flagData, "", "Hex encoded call data or contract creation code")
+	cmd.Flags().Uint64(flagNonce, 0, "Nonce of the sender, fetched from --evm-rpc if not set")
+	cmd.Flags().Uint64(flagGas, 0, "Gas limit, estimated through --evm-rpc if not set")
+	cmd.Flags().String(flagGasFeeCap, "", "Max fee per gas in wei, from --evm-rpc if not set")
+	cmd.Flags().String(flagGasTipCap, "", "Priority fee per gas in wei, from --evm-rpc if not set")
+	cmd.Flags().Uint64(flagEthChainID, 0, "EVM chain ID, fetched from --evm-rpc if not set")
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+}
+
+// signTx builds the transaction given by the flags of the command, filling the fields that are not
+// set through JSON-RPC, and signs it with the keyring key given by --from.
+func signTx(cmd *cobra.Command) (*ethtypes.Transaction, error) {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+	fs := cmd.Flags()
+
+	keyName, _ := fs.GetString(flags.FlagFrom)
+	record, err := clientCtx.Keyring.Key(keyName)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+	from := common.BytesToAddress(pubKey.Address())
+
+	msg := ethereum.CallMsg{From: from, Value: new(big.Int)}
+	if to, _ := fs.GetString(flagTo); to != "" {
+		var address common.Address
+		if address, err = parseAddress(to); err != nil {
+			return nil, err
+		}
+		msg.To = &address
+	}
+	if value, _ := fs.GetString(flagValue); value != "" {
+		if _, ok := msg.Value.SetString(value, 10); !ok { //nolint:gomnd // decimal.
+			return nil, fmt.Errorf("invalid --%s %s", flagValue, value)
+		}
+	}
+	if data, _ := fs.GetString(flagData); data != "" {
+		if msg.Data, err = hexutil.Decode(data); err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", flagData, err)
+		}
+	}
+
+	// The JSON-RPC endpoint is only dialed if a field must be fetched from it.
+	var ec *ethclient.Client
+	rpc := func() (*ethclient.Client, error) {
+		if ec == nil {
+			rpcURL, _ := fs.GetString(flagEVMRPC)
+			dialed, dialErr := ethclient.DialContext(cmd.Context(), rpcURL)
+			if dialErr != nil {
+				return nil, dialErr
+			}
+			ec = dialed
+		}
+		return ec, nil
+	}
+	defer func() {
+		if ec != nil {
+			ec.Close()
+		}
+	}()
+
+	fields, err := fillTxFields(cmd.Context(), cmd, rpc, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:   fields.chainID,
+		Nonce:     fields.nonce,
+		GasTipCap: fields.gasTipCap,
+		GasFeeCap: fields.gasFeeCap,
+		Gas:       fields.gas,
+		To:        msg.To,
+		Value:     msg.Value,
+		Data:      msg.Data,
+	})
+	signer := ethtypes.LatestSignerForChainID(fields.chainID)
+	sig, _, err := clientCtx.Keyring.Sign(
+		keyName, signer.Hash(tx).Bytes(), signingtypes.SignMode_SIGN_MODE_DIRECT,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return tx.WithSignature(signer, sig)
+}
+
+// txFields are the fields of a transaction that may be fetched through JSON-RPC.
+type txFields struct {
+	chainID   *big.Int
+	nonce     uint64
+	gas       uint64
+	gasTipCap *big.Int
+	gasFeeCap *big.Int
+}
+
+// fillTxFields returns the fields given by the flags of the command, fetching the ones that are
+// not set through JSON-RPC.
+func fillTxFields(
+	ctx context.Context,
+	cmd *cobra.Command,
+	rpc func() (*ethclient.Client, error),
+	msg ethereum.CallMsg,
+) (*txFields, error) {
+	var (
+		fs     = cmd.Flags()
+		fields = new(txFields)
+		ec     *ethclient.Client
+		err    error
+	)
+
+	if fs.Changed(flagEthChainID) {
+		chainID, _ := fs.GetUint64(flagEthChainID)
+		fields.chainID = new(big.Int).SetUint64(chainID)
+	} else if ec, err = rpc(); err == nil {
+		fields.chainID, err = ec.ChainID(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if fs.Changed(flagNonce) {
+		fields.nonce, _ = fs.GetUint64(flagNonce)
+	} else if ec, err = rpc(); err == nil {
+		fields.nonce, err = ec.PendingNonceAt(ctx, msg.From)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if fields.gasTipCap, err = bigIntFlag(cmd, flagGasTipCap); err != nil {
+		return nil, err
+	} else if fields.gasTipCap == nil {
+		if ec, err = rpc(); err == nil {
+			fields.gasTipCap, err = ec.SuggestGasTipCap(ctx)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if fields.gasFeeCap, err = bigIntFlag(cmd, flagGasFeeCap); err != nil {
+		return nil, err
+	} else if fields.gasFeeCap == nil {
+		// Leave room for the base fee to double, as geth does.
+		var head *ethtypes.Header
+		if ec, err = rpc(); err == nil {
+			head, err = ec.HeaderByNumber(ctx, nil)
+		}
+		if err != nil {
+			return nil, err
+		}
+		fields.gasFeeCap = new(big.Int).Set(fields.gasTipCap)
+		if head.BaseFee != nil {
+			fields.gasFeeCap.Add(
+				fields.gasFeeCap, new(big.Int).Mul(head.BaseFee, big.NewInt(2)), //nolint:gomnd // 2x.
+			)
+		}
+	}
+
+	if fs.Changed(flagGas) {
+		fields.gas, _ = fs.GetUint64(flagGas)
+	} else if ec, err = rpc(); err == nil {
+		msg.GasTipCap, msg.GasFeeCap = fields.gasTipCap, fields.gasFeeCap
+		fields.gas, err = ec.EstimateGas(ctx, msg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// bigIntFlag returns the value of the given decimal flag, or nil if it is not set.
+func bigIntFlag(cmd *cobra.Command, name string) (*big.Int, error) {
+	s, err := cmd.Flags().GetString(name)
+	if err != nil || s == "" {
+		return nil, err
+	}
+	value, ok := new(big.Int).SetString(s, 10) //nolint:gomnd // decimal.
+	if !ok {
+		return nil, fmt.Errorf("invalid --%s %s", name, s)
+	}
+	return value, nil
+}
@@ -24,6 +24,8 @@
 package evm
 
 import (
+	"path/filepath"
+
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	store "cosmossdk.io/store/types"
@@ -31,10 +33,14 @@ import (
 	modulev1alpha1 "github.com/berachain/polaris/cosmos/api/polaris/evm/module/v1alpha1"
 	"github.com/berachain/polaris/cosmos/config"
 	"github.com/berachain/polaris/cosmos/x/evm/keeper"
+	"github.com/berachain/polaris/cosmos/x/evm/types"
 	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 //nolint:gochecknoinits // GRRRR fix later.
@@ -75,14 +81,27 @@ func ProvideModule(in DepInjectInput) DepInjectOutput {
 		in.CustomPrecompiles = func() *ethprecompile.Injector { return &ethprecompile.Injector{} }
 	}
 
+	// Default to the governance module as the authority if not set in the module config.
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
 	k := keeper.NewKeeper(
 		in.AccountKeeper,
 		in.Key,
 		in.CustomPrecompiles,
 		in.QueryContextFn,
 		in.PolarisCfg(),
+		authority.String(),
 	)
 	m := NewAppModule(k, in.AccountKeeper)
+	if home, ok := in.AppOpts.Get(flags.FlagHome).(string); ok && home != "" {
+		m.genesisDir = filepath.Join(home, "config")
+	}
+	if stream, ok := in.AppOpts.Get(types.FlagGenesisStream).(string); ok {
+		m.genesisStream = stream
+	}
 
 	return DepInjectOutput{
 		Keeper: k,
@@ -25,7 +25,10 @@ package evm
 
 import (
 	"encoding/json"
+	"errors"
+	"path/filepath"
 
+	"github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -52,14 +55,20 @@ func (AppModuleBasic) ValidateGenesis(
 	_ client.TxEncodingConfig,
 	bz json.RawMessage,
 ) error {
+	// The content of a streamed genesis is checked against its hash and validated when it is
+	// read, by InitGenesis and `genesis validate-evm`, as the node home is not known here.
+	if ref, ok := types.GenesisStreamRefFrom(bz); ok {
+		return ref.Validate()
+	}
+
 	ethGen := new(core.Genesis)
 	if err := ethGen.UnmarshalJSON(bz); err != nil {
 		return err
 	}
 
-	// TODO: figure out what in geth we need to call in order to validate the genesis.
-
-	return nil
+	// The consistency with the auth module's genesis is checked by `genesis validate-evm`, as
+	// the genesis of other modules is not available here.
+	return types.ValidateGenesis(ethGen, nil)
 }
 
 // InitGenesis performs genesis initialization for the evm module. It returns
@@ -69,6 +78,19 @@ func (am AppModule) InitGenesis(
 	_ codec.JSONCodec,
 	data json.RawMessage,
 ) []abci.ValidatorUpdate {
+	// Large genesis states are streamed from a separate file instead.
+	if ref, ok := types.GenesisStreamRefFrom(data); ok {
+		f, err := ref.Open(am.genesisDir)
+		if err != nil {
+			panic(err)
+		}
+		defer f.Close()
+		if err = am.keeper.InitGenesisStream(ctx, f); err != nil {
+			panic(err)
+		}
+		return []abci.ValidatorUpdate{}
+	}
+
 	var ethGen core.Genesis
 	if err := ethGen.UnmarshalJSON(data); err != nil {
 		panic(err)
@@ -81,8 +103,17 @@ func (am AppModule) InitGenesis(
 }
 
 // ExportGenesis returns the exported genesis state as raw bytes for the evm
-// module.
+// module. If a genesis stream file is configured, the state is streamed to it instead, so that it
+// is never held in memory, and the returned genesis state references it.
 func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMessage {
+	if am.genesisStream != "" {
+		ref, err := am.exportGenesisStream(ctx)
+		if err != nil {
+			panic(err)
+		}
+		return ref
+	}
+
 	ethGen := am.keeper.ExportGenesis(ctx)
 	ethGenBz, err := ethGen.MarshalJSON()
 	if err != nil {
@@ -90,3 +121,22 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMe
 	}
 	return ethGenBz
 }
+
+// exportGenesisStream streams the genesis state to the genesis stream file and returns the
+// reference to it. An existing file is never overwritten. The reference holds the name of the
+// file, which is resolved in the config directory, so the file belongs next to genesis.json.
+func (am AppModule) exportGenesisStream(ctx sdk.Context) (_ json.RawMessage, err error) {
+	f, err := types.CreateGenesisStream(am.genesisStream)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errors.Join(err, f.Close()) }()
+
+	hash, err := am.keeper.ExportGenesisStream(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&types.GenesisStreamRef{
+		Stream: filepath.Base(am.genesisStream), Hash: hash,
+	})
+}
@@ -24,8 +24,14 @@
 package evm_test
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -35,13 +41,18 @@ import (
 	testutil "github.com/berachain/polaris/cosmos/testutil"
 	"github.com/berachain/polaris/cosmos/x/evm"
 	"github.com/berachain/polaris/cosmos/x/evm/keeper"
+	"github.com/berachain/polaris/cosmos/x/evm/plugins/historical"
 	"github.com/berachain/polaris/cosmos/x/evm/plugins/state"
+	"github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
 	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
 	"github.com/berachain/polaris/eth/params"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/beacon"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
@@ -74,6 +85,7 @@ var _ = Describe("Genesis", func() {
 		ethGen.Config = params.DefaultChainConfig
 		cfg.Node.DataDir = GinkgoT().TempDir()
 		cfg.Node.KeyStoreDir = GinkgoT().TempDir()
+		cfg.Polar.HistoricalDB.Backend = historical.BackendMemory
 		k = keeper.NewKeeper(
 			ak,
 			testutil.EvmKey,
@@ -86,11 +98,10 @@ var _ = Describe("Genesis", func() {
 				}
 			},
 			cfg,
+			authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		)
-		err = k.Setup(
-			chain.New(core.NewChain(k.Host, params.DefaultChainConfig, beacon.NewFaker()), nil, nil),
-			nil,
-		)
+		bc := core.NewChain(k.Host, params.DefaultChainConfig, beacon.NewFaker(), nil)
+		err = k.Setup(chain.New(bc, nil, nil, nil, nil), nil, bc.SetConfig)
 		Expect(err).ToNot(HaveOccurred())
 
 		err = k.SetupPrecompiles()
@@ -146,6 +157,79 @@ var _ = Describe("Genesis", func() {
 		})
 	})
 
+	Describe("On InitGenesis of a streamed genesis", func() {
+		var (
+			path string
+			hash string
+		)
+		BeforeEach(func() {
+			addresses := make([]common.Address, 0, len(ethGen.Alloc))
+			for address := range ethGen.Alloc {
+				addresses = append(addresses, address)
+			}
+			slices.SortFunc(addresses, func(a, b common.Address) int { return a.Cmp(b) })
+
+			path = filepath.Join(GinkgoT().TempDir(), "evm_genesis.jsonl")
+			f, err := os.Create(path)
+			Expect(err).ToNot(HaveOccurred())
+			defer f.Close()
+			writer := types.NewGenesisStreamWriter(f)
+			Expect(writer.WriteHeader(ethGen, ethGen.ToBlock().Root())).To(Succeed())
+			for _, address := range addresses {
+				account := ethGen.Alloc[address]
+				Expect(writer.WriteRecord(&types.GenesisAccountRecord{
+					Address: address, Account: &account,
+				})).To(Succeed())
+			}
+			Expect(writer.Flush()).To(Succeed())
+			hash = writer.Hash()
+		})
+
+		initGenesis := func(ref *types.GenesisStreamRef) {
+			bz, err := json.Marshal(ref)
+			Expect(err).ToNot(HaveOccurred())
+			am.InitGenesis(ctx, nil, bz)
+		}
+
+		It("should init the same genesis block as the genesis held in memory", func() {
+			initGenesis(&types.GenesisStreamRef{Stream: path, Hash: hash})
+			Expect(k.Host.GetBlockPlugin().GetHeaderByNumber(0)).To(
+				Equal(ethGen.ToBlock().Header()),
+			)
+			sp := k.Host.GetStatePluginFactory().NewPluginFromContext(ctx)
+			for addr, acc := range ethGen.Alloc {
+				Expect(sp.GetCode(addr)).To(Equal(acc.Code))
+			}
+		})
+
+		It("should export a stream that derives its state root", func() {
+			initGenesis(&types.GenesisStreamRef{Stream: path, Hash: hash})
+			var buf bytes.Buffer
+			exportedHash, err := k.ExportGenesisStream(ctx, &buf)
+			Expect(err).ToNot(HaveOccurred())
+			sum := sha256.Sum256(buf.Bytes())
+			Expect(exportedHash).To(Equal(hex.EncodeToString(sum[:])))
+			Expect(types.ValidateGenesisStream(&buf, nil)).To(Succeed())
+		})
+
+		It("should reject a stream that does not match its hash", func() {
+			Expect(func() {
+				initGenesis(&types.GenesisStreamRef{Stream: path, Hash: hex.EncodeToString(
+					make([]byte, sha256.Size),
+				)})
+			}).To(PanicWith(MatchError(types.ErrInvalidGenesisStream)))
+			Expect(evm.AppModuleBasic{}.ValidateGenesis(
+				nil, nil, json.RawMessage(`{"stream": "evm_genesis.jsonl"}`),
+			)).To(MatchError(types.ErrInvalidGenesisStream))
+		})
+
+		It("should reject a relative path without a node home", func() {
+			Expect(func() {
+				initGenesis(&types.GenesisStreamRef{Stream: filepath.Base(path), Hash: hash})
+			}).To(PanicWith(MatchError(ContainSubstring("relative path"))))
+		})
+	})
+
 	Describe("On ExportGenesis", func() {
 		var (
 			actualGenesis core.Genesis
@@ -165,10 +249,103 @@ var _ = Describe("Genesis", func() {
 
 		Context("when the genesis is valid", func() {
 			It("should export without fail", func() {
-				ethGen.Config = nil
+				// The chain config is exported from the one stored on chain.
+				cfg, err := k.GetChainConfig(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cfg.ChainConfig).To(Equal(params.DefaultChainConfig))
+				Expect(actualGenesis.Config).To(Equal(cfg.ChainConfig))
+
+				// Zero big integers decode with empty rather than nil words, so compare the JSON.
 				ethGen.BaseFee = big.NewInt(int64(ethparams.InitialBaseFee))
-				Expect(actualGenesis).To(Equal(*ethGen))
+				expected, err := json.Marshal(ethGen)
+				Expect(err).ToNot(HaveOccurred())
+				actual, err := json.Marshal(actualGenesis)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actual).To(MatchJSON(expected))
 			})
 		})
+
+		Context("when a genesis stream file is configured", func() {
+			It("should stream to a new file and never overwrite it", func() {
+				path := filepath.Join(GinkgoT().TempDir(), "evm_genesis.jsonl")
+				ref, ok := types.GenesisStreamRefFrom(
+					am.WithGenesisStream(path).ExportGenesis(ctx, nil),
+				)
+				Expect(ok).To(BeTrue())
+				Expect(ref.Stream).To(Equal("evm_genesis.jsonl"))
+				f, err := ref.Open(filepath.Dir(path))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(f.Close()).To(Succeed())
+
+				Expect(func() {
+					am.WithGenesisStream(path).ExportGenesis(ctx, nil)
+				}).To(PanicWith(MatchError(os.ErrExist)))
+			})
+		})
+	})
+})
+
+var _ = Describe("ValidateGenesis", func() {
+	var gen *core.Genesis
+
+	BeforeEach(func() {
+		gen = &core.Genesis{
+			Config:     params.DefaultChainConfig,
+			GasLimit:   core.DefaultGenesis.GasLimit,
+			Difficulty: big.NewInt(0),
+			Alloc: core.GenesisAlloc{
+				common.HexToAddress("0x1"): {Balance: big.NewInt(1), Nonce: 1},
+			},
+		}
+	})
+
+	validate := func() error {
+		bz, err := json.Marshal(gen)
+		Expect(err).ToNot(HaveOccurred())
+		return evm.AppModuleBasic{}.ValidateGenesis(nil, nil, bz)
+	}
+
+	It("should accept the default genesis", func() {
+		bz, err := json.Marshal(core.DefaultGenesis)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(evm.AppModuleBasic{}.ValidateGenesis(nil, nil, bz)).To(Succeed())
+	})
+
+	It("should reject a gas limit out of bounds", func() {
+		gen.GasLimit = ethparams.MinGasLimit - 1
+		Expect(validate()).To(MatchError(types.ErrInvalidGenesis))
+	})
+
+	It("should reject a chain config with forks out of order", func() {
+		cfg := *params.DefaultChainConfig
+		cfg.LondonBlock = nil
+		gen.Config = &cfg
+		Expect(validate()).To(MatchError(ContainSubstring("chain config")))
+	})
+
+	It("should report all the problems with the alloc at once", func() {
+		gen.BaseFee = big.NewInt(-1)
+		gen.Alloc[common.HexToAddress("0x2")] = core.GenesisAccount{
+			Balance: big.NewInt(-1),
+			Storage: map[common.Hash]common.Hash{{1}: {1}},
+		}
+		gen.Alloc[common.HexToAddress("0x3")] = core.GenesisAccount{
+			Balance: big.NewInt(0),
+			Code:    []byte{0xEF},
+		}
+		err := types.ValidateGenesis(gen, nil)
+		Expect(err).To(MatchError(ContainSubstring("base fee -1")))
+		Expect(err).To(MatchError(ContainSubstring("balance -1")))
+		Expect(err).To(MatchError(ContainSubstring("has storage but no code")))
+		Expect(err).To(MatchError(ContainSubstring("reserved 0xEF byte")))
+	})
+
+	It("should reject nonces inconsistent with the auth genesis", func() {
+		Expect(types.ValidateGenesis(gen, map[common.Address]uint64{
+			common.HexToAddress("0x1"): 1,
+		})).To(Succeed())
+		Expect(types.ValidateGenesis(gen, map[common.Address]uint64{
+			common.HexToAddress("0x1"): 2,
+		})).To(MatchError(ContainSubstring("account nonce mismatch")))
 	})
 })
@@ -49,9 +49,16 @@ func (k *Keeper) EndBlock(ctx context.Context) error {
 	return k.chain.SetFinalizedBlock()
 }
 
-// PrepareCheckState runs on the Cosmos-SDK lifecycle PrepareCheckState() during ABCI Commit.
+// PrepareCheckState runs on the Cosmos-SDK lifecycle PrepareCheckState() during ABCI Commit. It
+// applies the chain config stored on-chain, so that updates made in the committed block are in
+// effect from the next block on, which is proposed and processed after the commit.
 func (k *Keeper) PrepareCheckState(ctx context.Context) error {
 	k.spf.SetLatestQueryContext(ctx)
 	k.chain.PrimePlugins(ctx)
-	return nil
+
+	cfg, err := k.GetChainConfig(sdk.UnwrapSDKContext(ctx))
+	if err != nil || cfg == nil {
+		return err
+	}
+	return k.applyChainConfig(cfg.ChainConfig)
 }
@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// chainConfigKey is the key of the chain config in the evm store.
+var chainConfigKey = []byte{types.ChainConfigPrefix}
+
+// GetChainConfig returns the chain config stored on-chain, or nil if none is stored, which is
+// the case for chains initialized before the chain config was stored on-chain.
+func (k *Keeper) GetChainConfig(ctx sdk.Context) (*types.ChainConfig, error) {
+	bz := ctx.KVStore(k.storeKey).Get(chainConfigKey)
+	if bz == nil {
+		return nil, nil //nolint:nilnil // no chain config stored.
+	}
+	return types.UnmarshalChainConfig(bz)
+}
+
+// SetChainConfig stores the given chain config on-chain. It is applied to the node once the
+// block is committed.
+func (k *Keeper) SetChainConfig(ctx sdk.Context, cfg *types.ChainConfig) error {
+	bz, err := types.MarshalChainConfig(cfg)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(chainConfigKey, bz)
+	return nil
+}
+
+// LoadChainConfig applies the chain config stored on-chain to the node on startup. It returns
+// an error if the chain config of the node conflicts with it, in which case the node must not
+// start as it would fork itself off the chain.
+func (k *Keeper) LoadChainConfig(ctx sdk.Context) error {
+	cfg, err := k.GetChainConfig(ctx)
+	if err != nil || cfg == nil {
+		return err
+	}
+	if err = types.CheckChainConfigConflict(k.chain.Config(), cfg.ChainConfig); err != nil {
+		return err
+	}
+	return k.applyChainConfig(cfg.ChainConfig)
+}
+
+// IsPrevRandao returns whether the random value of the execution payload with the given time is
+// derived from consensus data, as activated by the chain config stored on-chain.
+func (k *Keeper) IsPrevRandao(ctx sdk.Context, time uint64) bool {
+	cfg, err := k.GetChainConfig(ctx)
+	if err != nil || cfg == nil {
+		return false
+	}
+	return cfg.IsPrevRandao(time)
+}
+
+// EVMChainID returns the EVM chain ID of the on-chain chain config, which Cosmos transactions
+// signed as EIP-712 typed data are signed for. The context must be an SDK context.
+func (k *Keeper) EVMChainID(ctx context.Context) (*big.Int, error) {
+	if _, ok := ctx.(sdk.Context); !ok && ctx.Value(sdk.SdkContextKey) == nil {
+		return nil, errors.New("EVM chain ID requires an SDK context")
+	}
+	cfg, err := k.currentChainConfig(sdk.UnwrapSDKContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return cfg.ChainID, nil
+}
+
+// UpdateParams replaces the chain config with the one of the message, which must be signed by
+// the authority.
+func (k *Keeper) UpdateParams(
+	ctx context.Context, msg *types.MsgUpdateParams,
+) (*types.MsgUpdateParamsResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	next, err := types.UnmarshalChainConfig(msg.ChainConfig)
+	if err != nil {
+		return nil, err
+	}
+	if err = k.updateChainConfig(sdk.UnwrapSDKContext(ctx), next); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// ScheduleHardfork schedules a time based hardfork at the time of the message, which must be
+// signed by the authority.
+func (k *Keeper) ScheduleHardfork(
+	ctx context.Context, msg *types.MsgScheduleHardfork,
+) (*types.MsgScheduleHardforkResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	sCtx := sdk.UnwrapSDKContext(ctx)
+	if blockTime := uint64(sCtx.BlockTime().Unix()); msg.Time <= blockTime {
+		return nil, fmt.Errorf("%w: %s must activate after the current block time %d",
+			types.ErrInvalidHardfork, msg.Name, blockTime)
+	}
+	current, err := k.currentChainConfig(sCtx)
+	if err != nil {
+		return nil, err
+	}
+	next, err := types.ScheduleHardfork(current, msg.Name, msg.Time)
+	if err != nil {
+		return nil, err
+	}
+	if err = k.updateChainConfig(sCtx, next); err != nil {
+		return nil, err
+	}
+	return &types.MsgScheduleHardforkResponse{}, nil
+}
+
+// updateChainConfig validates and stores the next chain config.
+func (k *Keeper) updateChainConfig(ctx sdk.Context, next *types.ChainConfig) error {
+	current, err := k.currentChainConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if err = types.ValidateChainConfigUpdate(
+		current, next, big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix()),
+	); err != nil {
+		return err
+	}
+	k.Logger(ctx).Info("updated chain config", "config", next)
+	return k.SetChainConfig(ctx, next)
+}
+
+// currentChainConfig returns the chain config stored on-chain, or the one of the node if none is
+// stored.
+func (k *Keeper) currentChainConfig(ctx sdk.Context) (*types.ChainConfig, error) {
+	cfg, err := k.GetChainConfig(ctx)
+	if err != nil || cfg != nil {
+		return cfg, err
+	}
+	return &types.ChainConfig{ChainConfig: k.chain.Config()}, nil
+}
+
+// applyChainConfig replaces the chain config of the node. It is a no-op if the chain config did
+// not change.
+func (k *Keeper) applyChainConfig(cfg *params.ChainConfig) error {
+	bz, err := types.MarshalChainConfig(&types.ChainConfig{ChainConfig: cfg})
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(bz, k.appliedChainConfig) {
+		return nil
+	}
+	k.setChainConfig(cfg)
+	k.appliedChainConfig = bz
+	return nil
+}
+
+// checkAuthority returns an error if the given address is not the authority of the module.
+func (k *Keeper) checkAuthority(authority string) error {
+	if authority != k.authority {
+		return fmt.Errorf("%w: expected %s, got %s", types.ErrInvalidAuthority, k.authority,
+			authority)
+	}
+	return nil
+}
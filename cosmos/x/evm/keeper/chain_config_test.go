@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package keeper_test
+
+import (
+	"math/big"
+	"time"
+
+	"cosmossdk.io/log"
+
+	"github.com/berachain/polaris/cosmos/config"
+	"github.com/berachain/polaris/cosmos/runtime/chain"
+	testutil "github.com/berachain/polaris/cosmos/testutil"
+	"github.com/berachain/polaris/cosmos/x/evm/keeper"
+	"github.com/berachain/polaris/cosmos/x/evm/plugins/historical"
+	"github.com/berachain/polaris/cosmos/x/evm/plugins/state"
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+	"github.com/berachain/polaris/eth/core"
+	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
+	"github.com/berachain/polaris/eth/params"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/ethereum/go-ethereum/consensus/beacon"
+	ethparams "github.com/ethereum/go-ethereum/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Chain Config", func() {
+	var (
+		ctx       sdk.Context
+		k         *keeper.Keeper
+		bc        core.Blockchain
+		local     ethparams.ChainConfig
+		authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+		blockTime = time.Unix(1_000, 0)
+	)
+
+	BeforeEach(func() {
+		var ak state.AccountKeeper
+		ctx, ak, _, _ = testutil.SetupMinimalKeepers(log.NewTestLogger(GinkgoT()))
+		cfg := config.DefaultPolarisConfig()
+		ctx = ctx.WithBlockHeight(1).WithBlockTime(blockTime)
+		cfg.Node.DataDir = GinkgoT().TempDir()
+		cfg.Node.KeyStoreDir = GinkgoT().TempDir()
+		cfg.Polar.HistoricalDB.Backend = historical.BackendMemory
+
+		local = *params.DefaultChainConfig
+		k = keeper.NewKeeper(
+			ak,
+			testutil.EvmKey,
+			func() *ethprecompile.Injector { return ethprecompile.NewPrecompiles() },
+			func() func(height int64, prove bool) (sdk.Context, error) {
+				return func(height int64, prove bool) (sdk.Context, error) {
+					return ctx, nil
+				}
+			},
+			cfg,
+			authority,
+		)
+		bc = core.NewChain(k.Host, &local, beacon.NewFaker(), nil)
+		Expect(k.Setup(chain.New(bc, nil, nil, nil, nil), nil, bc.SetConfig)).To(Succeed())
+		Expect(k.SetChainConfig(ctx, &types.ChainConfig{ChainConfig: &local})).To(Succeed())
+	})
+
+	It("should schedule a hardfork authorized by governance", func() {
+		forkTime := uint64(blockTime.Unix() + 100)
+		_, err := k.ScheduleHardfork(ctx, &types.MsgScheduleHardfork{
+			Authority: authority, Name: "prague", Time: forkTime,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		onChain, err := k.GetChainConfig(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*onChain.PragueTime).To(Equal(forkTime))
+
+		// The node only applies the hardfork once the block is committed, by swapping the config.
+		Expect(bc.Config().PragueTime).To(BeNil())
+		Expect(k.PrepareCheckState(ctx)).To(Succeed())
+		Expect(*bc.Config().PragueTime).To(Equal(forkTime))
+		Expect(local.PragueTime).To(BeNil())
+	})
+
+	It("should schedule the derivation of the random value of blocks", func() {
+		forkTime := uint64(blockTime.Unix() + 100)
+		Expect(k.IsPrevRandao(ctx, forkTime)).To(BeFalse())
+		_, err := k.ScheduleHardfork(ctx, &types.MsgScheduleHardfork{
+			Authority: authority, Name: "prevrandao", Time: forkTime,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(k.IsPrevRandao(ctx, forkTime-1)).To(BeFalse())
+		Expect(k.IsPrevRandao(ctx, forkTime)).To(BeTrue())
+
+		// The EVM chain config of the node is left untouched.
+		Expect(k.PrepareCheckState(ctx)).To(Succeed())
+		Expect(bc.Config()).To(Equal(&local))
+	})
+
+	It("should reject hardforks not authorized by governance", func() {
+		_, err := k.ScheduleHardfork(ctx, &types.MsgScheduleHardfork{
+			Authority: sdk.AccAddress(testutil.Alice.Bytes()).String(),
+			Name:      "prague",
+			Time:      uint64(blockTime.Unix() + 100),
+		})
+		Expect(err).To(MatchError(types.ErrInvalidAuthority))
+	})
+
+	It("should reject hardforks that are not in the future", func() {
+		_, err := k.ScheduleHardfork(ctx, &types.MsgScheduleHardfork{
+			Authority: authority, Name: "prague", Time: uint64(blockTime.Unix()),
+		})
+		Expect(err).To(MatchError(types.ErrInvalidHardfork))
+	})
+
+	It("should reject updates that change the chain ID", func() {
+		next := local
+		next.ChainID = big.NewInt(1)
+		bz, err := types.MarshalChainConfig(&types.ChainConfig{ChainConfig: &next})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = k.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, ChainConfig: bz})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should refuse to load a conflicting on-chain chain config", func() {
+		onChain := local
+		onChain.ChainID = big.NewInt(1)
+		Expect(k.SetChainConfig(ctx, &types.ChainConfig{ChainConfig: &onChain})).To(Succeed())
+		Expect(k.LoadChainConfig(ctx)).To(MatchError(types.ErrChainConfigConflict))
+	})
+})
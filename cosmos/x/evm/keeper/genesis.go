@@ -24,20 +24,57 @@
 package keeper
 
 import (
+	"errors"
+	"io"
+
 	"github.com/berachain/polaris/cosmos/x/evm/plugins"
+	"github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
 	"github.com/berachain/polaris/lib/utils"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // InitGenesis is called during the InitGenesis.
 func (k *Keeper) InitGenesis(ctx sdk.Context, genState *core.Genesis) error {
-	// TODO: Feels jank as fuck lol, but it works.
-	genState.Config = k.chain.Config()
+	return k.initGenesis(ctx, genState, nil, nil)
+}
+
+// InitGenesisStream is InitGenesis for a streamed genesis read from r. The alloc is written to
+// the state one record at a time and the genesis block has the state root of the stream, which
+// the alloc must derive.
+func (k *Keeper) InitGenesisStream(ctx sdk.Context, r io.Reader) (err error) {
+	reader := types.NewGenesisStreamReader(r)
+	defer func() { err = errors.Join(err, reader.Close()) }()
+	header, err := reader.ReadHeader()
+	if err != nil {
+		return err
+	}
+	return k.initGenesis(ctx, header.Genesis, header, reader)
+}
+
+// initGenesis initializes the chain config and the plugins with the given genesis, whose alloc is
+// read from the given streamed genesis if any.
+func (k *Keeper) initGenesis(
+	ctx sdk.Context, genState *core.Genesis,
+	header *types.GenesisStreamHeader, reader *types.GenesisStreamReader,
+) error {
+	if err := k.initChainConfig(ctx, genState); err != nil {
+		return err
+	}
 
 	// Initialize all the plugins.
 	for _, plugin := range k.Host.GetAllPlugins() {
+		if header != nil {
+			if plugin, ok := utils.GetAs[plugins.HasGenesisStream](plugin); ok {
+				if err := plugin.InitGenesisStream(ctx, header, reader); err != nil {
+					return err
+				}
+				continue
+			}
+		}
 		// checks whether plugin implements methods of HasGenesis and executes them if it does
 		if plugin, ok := utils.GetAs[plugins.HasGenesis](plugin); ok {
 			if err := plugin.InitGenesis(ctx, genState); err != nil {
@@ -46,10 +83,15 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, genState *core.Genesis) error {
 		}
 	}
 
+	block := genState.ToBlock()
+	if header != nil {
+		block = header.Block()
+	}
+
 	// Insert to chain with the genesis context. The plugins are already prepared with their
 	// InitGenesis.
 	k.spf.SetGenesisContext(ctx)
-	return k.chain.WriteGenesisBlock(genState.ToBlock())
+	return k.chain.WriteGenesisBlock(block)
 }
 
 // ExportGenesis returns the exported genesis state.
@@ -60,5 +102,81 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *core.Genesis {
 			plugin.ExportGenesis(ctx, genesisState)
 		}
 	}
+
+	cfg, err := k.currentChainConfig(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesisState.Config = cfg.ChainConfig
 	return genesisState
 }
+
+// ExportGenesisStream writes the genesis state to w as a streamed genesis without holding the
+// alloc in memory, and returns the hash of the written content. The state is walked twice: once
+// to derive the state root of the header, and once to write the alloc.
+func (k *Keeper) ExportGenesisStream(ctx sdk.Context, w io.Writer) (string, error) {
+	genesisState := new(core.Genesis)
+	k.bp.ExportGenesis(ctx, genesisState)
+	cfg, err := k.currentChainConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+	genesisState.Config = cfg.ChainConfig
+
+	stateRoot, err := k.genesisStateRoot(ctx)
+	if err != nil {
+		return "", err
+	}
+	writer := types.NewGenesisStreamWriter(w)
+	if err = writer.WriteHeader(genesisState, stateRoot); err != nil {
+		return "", err
+	}
+	if err = k.sp.IterateGenesisAlloc(ctx, writer.WriteRecord); err != nil {
+		return "", err
+	}
+	if err = writer.Flush(); err != nil {
+		return "", err
+	}
+	return writer.Hash(), nil
+}
+
+// ExportGenesisStreamAtHeight is ExportGenesisStream for the state at the given height, read
+// through the historical query context. A height of 0 exports the latest state.
+func (k *Keeper) ExportGenesisStreamAtHeight(height int64, w io.Writer) (string, error) {
+	ctx, err := k.qc()(height, false)
+	if err != nil {
+		return "", err
+	}
+	return k.ExportGenesisStream(ctx, w)
+}
+
+// genesisStateRoot returns the state root of the alloc exported from the state.
+func (k *Keeper) genesisStateRoot(ctx sdk.Context) (_ common.Hash, err error) {
+	hasher, err := types.NewGenesisRootHasher()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	defer func() { err = errors.Join(err, hasher.Close()) }()
+	if err = k.sp.IterateGenesisAlloc(ctx, hasher.Add); err != nil {
+		return common.Hash{}, err
+	}
+	return hasher.Root()
+}
+
+// initChainConfig stores and applies the chain config of the genesis. The chain config of the
+// genesis must not conflict with the one of the node. If the genesis has none, the one of the
+// node is used. The random value of execution payloads is derived from consensus data from the
+// genesis on.
+func (k *Keeper) initChainConfig(ctx sdk.Context, genState *core.Genesis) error {
+	if genState.Config == nil {
+		genState.Config = k.chain.Config()
+	} else if err := types.CheckChainConfigConflict(k.chain.Config(), genState.Config); err != nil {
+		return err
+	}
+	if err := k.SetChainConfig(ctx, &types.ChainConfig{
+		ChainConfig: genState.Config, PrevRandaoTime: new(uint64),
+	}); err != nil {
+		return err
+	}
+	return k.applyChainConfig(genState.Config)
+}
@@ -34,13 +34,20 @@ import (
 	"github.com/berachain/polaris/cosmos/x/evm/plugins/state"
 	"github.com/berachain/polaris/eth/core"
 	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
+	"github.com/berachain/polaris/eth/polar"
+	polarapi "github.com/berachain/polaris/eth/polar/api"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
-// Compile-time interface assertion.
-var _ core.PolarisHostChain = (*Host)(nil)
+// Compile-time interface assertions.
+var (
+	_ core.PolarisHostChain      = (*Host)(nil)
+	_ polar.CosmosEventsProvider = (*Host)(nil)
+)
 
 type Host struct {
 	// The various plugins that are are used to implement core.PolarisHostChain.
@@ -71,8 +78,17 @@ func NewHost(
 		sp:  state.NewPlugin(ak, storeKey, qc, nil),
 	}
 
+	// historical data is stored in a node-local database.
+	historicalDB, err := historical.OpenDatabase(cfg.Polar.HistoricalDB)
+	if err != nil {
+		panic(err)
+	}
+
 	// historical plugin requires block plugin.
-	h.hp = historical.NewPlugin(&cfg.Polar.Chain, h.bp, nil, storeKey)
+	h.hp = historical.NewPlugin(
+		&cfg.Polar.Chain, h.bp, historicalDB, storeKey, cfg.Polar.BlobSidecarRetention,
+		cfg.Polar.HistoricalDB.RetainBlocks, cfg.Polar.HistoricalDB.RetainAge,
+	)
 	h.spf = state.NewSPFactory(ak, storeKey, qc)
 	return h
 }
@@ -121,3 +137,23 @@ func (h *Host) Version() string {
 	return versionInfo.AppName + "/" + version.Version + ":" + "cosmos/" +
 		versionInfo.CosmosSdkVersion
 }
+
+// TxCosmosEvents returns the Cosmos events emitted by precompiles during the execution of the
+// transaction with the given hash.
+//
+// TxCosmosEvents implements `polar.CosmosEventsProvider`.
+func (h *Host) TxCosmosEvents(hash common.Hash) ([]*polarapi.CosmosEvent, error) {
+	events, err := h.hp.GetCosmosEventsByTxHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	cosmosEvents := make([]*polarapi.CosmosEvent, len(events))
+	for i, event := range events {
+		attributes := make([]polarapi.CosmosEventAttribute, len(event.Attributes))
+		for j, attr := range event.Attributes {
+			attributes[j] = polarapi.CosmosEventAttribute{Key: attr.Key, Value: attr.Value}
+		}
+		cosmosEvents[i] = &polarapi.CosmosEvent{Type: event.Type, Attributes: attributes}
+	}
+	return cosmosEvents, nil
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/berachain/polaris/cosmos/x/evm/plugins/state"
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// codeIndexInvariantRoute is the route of the invariant of the contract code reference counts.
+const codeIndexInvariantRoute = "code-index"
+
+// RegisterInvariants registers the invariants of the evm module.
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, codeIndexInvariantRoute, CodeIndexInvariant(k))
+}
+
+// CodeIndexInvariant checks that every stored contract code is referenced by as many accounts as
+// its reference count, with its size indexed correctly, and that no account's code is missing.
+func CodeIndexInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		err := state.CheckCodeIndex(ctx.KVStore(k.storeKey))
+		var msg string
+		if err != nil {
+			msg = fmt.Sprintf("contract code index is inconsistent:\n%v\n", err)
+		}
+		return sdk.FormatInvariant(types.ModuleName, codeIndexInvariantRoute, msg), err != nil
+	}
+}
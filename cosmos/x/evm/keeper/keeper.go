@@ -35,6 +35,8 @@ import (
 	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/params"
 )
 
 type Keeper struct {
@@ -44,6 +46,17 @@ type Keeper struct {
 	// provider is the struct that houses the Polaris EVM.
 	chain  core.Blockchain
 	txpool *txpool.Mempool
+
+	// storeKey is the key of the evm store, which holds the chain config.
+	storeKey storetypes.StoreKey
+	// qc returns the query context creator, used to read the state at past heights.
+	qc func() func(height int64, prove bool) (sdk.Context, error)
+	// authority is the address allowed to update the chain config, usually the x/gov module.
+	authority string
+	// setChainConfig applies a chain config to the node.
+	setChainConfig func(*params.ChainConfig)
+	// appliedChainConfig is the encoding of the on-chain chain config last applied to the node.
+	appliedChainConfig []byte
 }
 
 // NewKeeper creates new instances of the polaris Keeper.
@@ -53,6 +66,7 @@ func NewKeeper(
 	pcs func() *ethprecompile.Injector,
 	qc func() func(height int64, prove bool) (sdk.Context, error),
 	polarisCfg *config.Config,
+	authority string,
 ) *Keeper {
 	host := NewHost(
 		*polarisCfg,
@@ -62,20 +76,46 @@ func NewKeeper(
 		qc,
 	)
 	return &Keeper{
-		Host: host,
+		Host:      host,
+		storeKey:  storeKey,
+		qc:        qc,
+		authority: authority,
 	}
 }
 
-func (k *Keeper) Setup(chain core.Blockchain, txPool *txpool.Mempool) error {
+// Setup sets the blockchain and txpool of the node, and the function that applies a chain config
+// to the node, which is called between blocks, when no block is processed or built.
+func (k *Keeper) Setup(
+	chain core.Blockchain, txPool *txpool.Mempool, setChainConfig func(*params.ChainConfig),
+) error {
 	k.chain = chain
 	k.txpool = txPool
+	k.setChainConfig = setChainConfig
 	return k.SetupPrecompiles()
 }
 
+// SetCommitMultiStore sets the multistore that the evm store is committed to, so that requests
+// for the state at pruned heights are reported as such.
+func (k *Keeper) SetCommitMultiStore(cms storetypes.CommitMultiStore) {
+	k.spf.SetCommitMultiStore(cms)
+}
+
 func (k *Keeper) GetHost() core.PolarisHostChain {
 	return k.Host
 }
 
+// MigrateHistoricalData moves the historical blocks, receipts and tx lookups in the consensus
+// store, where chains used to keep them, into the node-local historical database. As it changes
+// the app hash, it must be run in consensus, e.g. from an upgrade handler.
+func (k *Keeper) MigrateHistoricalData(ctx sdk.Context) error {
+	migrated, err := k.hp.MigrateToOffChainDB(ctx)
+	if err != nil {
+		return err
+	}
+	k.Logger(ctx).Info("migrated historical data off-chain", "entries", migrated)
+	return nil
+}
+
 // Logger returns a module-specific logger.
 func (k *Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With(types.ModuleName)
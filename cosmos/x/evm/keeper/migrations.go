@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package keeper
+
+import (
+	"github.com/berachain/polaris/cosmos/x/evm/plugins/state"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator migrates the state of the evm module between consensus versions.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a Migrator for the given keeper.
+func NewMigrator(k *Keeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate1to2 reference counts the stored contract code and indexes its size, deleting the code
+// that was orphaned by self-destructed accounts.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	deleted, err := state.RebuildCodeIndex(ctx.KVStore(m.keeper.storeKey))
+	if err != nil {
+		return err
+	}
+	m.keeper.Logger(ctx).Info("migrated contract code to reference counting", "deleted", deleted)
+	return nil
+}
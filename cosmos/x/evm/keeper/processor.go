@@ -25,7 +25,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/big"
 	"time"
 
 	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
@@ -46,6 +48,7 @@ func (k *Keeper) ProcessPayloadEnvelope(
 	var (
 		err      error
 		block    *ethtypes.Block
+		sidecars []*ethtypes.BlobTxSidecar
 		envelope engine.ExecutionPayloadEnvelope
 	)
 	// TODO: maybe we just consume the block gas limit and call it a day?
@@ -63,7 +66,14 @@ func (k *Keeper) ProcessPayloadEnvelope(
 		return nil, fmt.Errorf("failed to unmarshal payload envelope: %w", err)
 	}
 
-	if block, err = engine.ExecutableDataToBlock(*envelope.ExecutionPayload, nil, nil); err != nil {
+	payload := envelope.ExecutionPayload
+	if payload == nil {
+		return nil, errors.New("payload envelope is missing execution payload")
+	}
+	if block, sidecars, err = evmtypes.EnvelopeToBlock(&envelope, evmtypes.ParentBeaconRoot(
+		k.chain.Config(), new(big.Int).SetUint64(payload.Number), payload.Timestamp,
+		sCtx.BlockHeader().AppHash,
+	)); err != nil {
 		k.Logger(sCtx).Error("failed to build evm block", "err", err)
 		return nil, err
 	}
@@ -84,9 +94,35 @@ func (k *Keeper) ProcessPayloadEnvelope(
 		return nil, err
 	}
 
+	// Persist the blob sidecars of the finalized block.
+	if err = k.chain.WriteBlobSidecars(block, sidecars); err != nil {
+		return nil, err
+	}
+
+	// Persist the Cosmos events emitted by precompiles during the finalized block.
+	if err = k.storeCosmosEvents(block); err != nil {
+		return nil, err
+	}
+
 	return &evmtypes.WrappedPayloadEnvelopeResponse{}, nil
 }
 
+// storeCosmosEvents persists the Cosmos events emitted by precompiles during the execution of
+// each transaction of the finalized block, including those that are not converted to Eth logs.
+func (k *Keeper) storeCosmosEvents(block *ethtypes.Block) error {
+	txEvents := k.spf.FinalizedPrecompileEvents()
+	for txIndex, tx := range block.Transactions() {
+		events, ok := txEvents[txIndex]
+		if !ok {
+			continue
+		}
+		if err := k.hp.StoreCosmosEvents(tx.Hash(), events.ToABCIEvents()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // EthTransaction implements the MsgServer interface. It is intentionally a no-op, but is required
 // for the cosmos-sdk to not freak out.
 func (k *Keeper) EthTransaction(
@@ -43,7 +43,7 @@ import (
 )
 
 // ConsensusVersion defines the current x/evm module consensus version.
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 var (
 	_ appmodule.HasServices          = AppModule{}
@@ -97,6 +97,11 @@ type AppModule struct {
 	AppModuleBasic
 	keeper    *keeper.Keeper
 	accKeeper AccountKeeper
+	// genesisDir is the config directory of the node home, which holds genesis.json. Relative
+	// paths of streamed genesis files are relative to it.
+	genesisDir string
+	// genesisStream is the file that ExportGenesis streams the genesis state to, if any.
+	genesisStream string
 }
 
 // NewAppModule creates a new AppModule object.
@@ -118,11 +123,21 @@ func (am AppModule) IsOnePerModuleType() {}
 func (am AppModule) IsAppModule() {}
 
 // RegisterInvariants registers the evm module invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
 	types.RegisterMsgServiceServer(registrar, am.keeper)
+
+	// Register the store migrations, which are only run by a module.Configurator.
+	if cfg, ok := registrar.(module.Configurator); ok {
+		m := keeper.NewMigrator(am.keeper)
+		if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -24,6 +24,7 @@
 package plugins
 
 import (
+	"github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -36,3 +37,9 @@ type HasGenesis interface {
 	InitGenesis(sdk.Context, *core.Genesis) error
 	ExportGenesis(sdk.Context, *core.Genesis)
 }
+
+// HasGenesisStream is implemented by the x/evm Polaris plugins with a genesis state that can be
+// read from a streamed genesis, whose alloc is read one record at a time.
+type HasGenesisStream interface {
+	InitGenesisStream(sdk.Context, *types.GenesisStreamHeader, *types.GenesisStreamReader) error
+}
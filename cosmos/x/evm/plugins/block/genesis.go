@@ -24,6 +24,7 @@
 package block
 
 import (
+	"github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -33,10 +34,22 @@ import (
 
 // InitGenesis stores the genesis block header in the KVStore under its own genesis key.
 func (p *plugin) InitGenesis(ctx sdk.Context, ethGen *core.Genesis) error {
+	return p.initGenesis(ctx, ethGen.ToBlock())
+}
+
+// InitGenesisStream is InitGenesis for a streamed genesis.
+func (p *plugin) InitGenesisStream(
+	ctx sdk.Context, header *types.GenesisStreamHeader, _ *types.GenesisStreamReader,
+) error {
+	return p.initGenesis(ctx, header.Block())
+}
+
+// initGenesis stores the header of the given genesis block.
+func (p *plugin) initGenesis(ctx sdk.Context, block *ethtypes.Block) error {
 	p.Prepare(ctx)
 
 	// Writing genesis block 0 to disk, available to query from any future IAVL height
-	return p.StoreHeader(ethGen.ToBlock().Header())
+	return p.StoreHeader(block.Header())
 }
 
 // Export genesis modifies a pointer to a genesis state object and populates it.
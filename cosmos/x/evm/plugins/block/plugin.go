@@ -36,6 +36,7 @@ import (
 
 type Plugin interface {
 	plugins.HasGenesis
+	plugins.HasGenesisStream
 	core.BlockPlugin
 }
 
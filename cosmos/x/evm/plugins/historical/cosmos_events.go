@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package historical
+
+import (
+	"encoding/json"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+	errorslib "github.com/berachain/polaris/lib/errors"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// StoreCosmosEvents stores the Cosmos events emitted during precompile execution by the
+// transaction with the given hash.
+func (p *plugin) StoreCosmosEvents(txHash common.Hash, events []abci.Event) error {
+	eventsBz, err := json.Marshal(events)
+	if err != nil {
+		return errorslib.Wrapf(
+			err, "failed to marshal cosmos events of tx %s", txHash.Hex())
+	}
+	return p.db().Put(historicalKey(types.TxHashKeyToCosmosEventsPrefix, txHash.Bytes()), eventsBz)
+}
+
+// GetCosmosEventsByTxHash returns the Cosmos events emitted during precompile execution by the
+// transaction with the given hash, which are empty if it did not call any precompile that emits
+// events.
+func (p *plugin) GetCosmosEventsByTxHash(txHash common.Hash) ([]abci.Event, error) {
+	db := p.db()
+	eventsBz, _ := db.Get(historicalKey(types.TxHashKeyToCosmosEventsPrefix, txHash.Bytes()))
+	if eventsBz == nil {
+		// distinguish transactions that did not emit any events from unknown transactions.
+		if _, err := p.GetTransactionByHash(txHash); err != nil {
+			return nil, err
+		}
+		return []abci.Event{}, nil
+	}
+
+	var events []abci.Event
+	if err := json.Unmarshal(eventsBz, &events); err != nil {
+		return nil, errorslib.Wrapf(
+			err, "failed to unmarshal cosmos events of tx %s", txHash.Hex())
+	}
+	return events, nil
+}
@@ -0,0 +1,261 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package historical
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+)
+
+var (
+	// errCosmosDBNotFound is returned when a key is not in the database.
+	errCosmosDBNotFound = errors.New("not found")
+	// errCosmosDBSnapshot is returned when a snapshot of the database is requested, which
+	// cosmos-db does not support.
+	errCosmosDBSnapshot = errors.New("snapshots are not supported by cosmos-db backends")
+)
+
+// cosmosDB is an ethdb.KeyValueStore over a cosmos-db database, which lets historical data be
+// stored in the backends cosmos-db supports, e.g. pebble.
+type cosmosDB struct {
+	db dbm.DB
+}
+
+// newCosmosDB returns an ethdb.KeyValueStore that stores its data in the given database.
+func newCosmosDB(db dbm.DB) ethdb.KeyValueStore {
+	return &cosmosDB{db: db}
+}
+
+// Has returns whether the key is in the database.
+func (db *cosmosDB) Has(key []byte) (bool, error) {
+	return db.db.Has(key)
+}
+
+// Get returns the value of the key, or an error if the key is not in the database.
+func (db *cosmosDB) Get(key []byte) ([]byte, error) {
+	value, err := db.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, errCosmosDBNotFound
+	}
+	return value, nil
+}
+
+// Put sets the value of the key.
+func (db *cosmosDB) Put(key []byte, value []byte) error {
+	return db.db.Set(key, value)
+}
+
+// Delete removes the key from the database.
+func (db *cosmosDB) Delete(key []byte) error {
+	return db.db.Delete(key)
+}
+
+// Stat returns the database statistic of the given property.
+func (db *cosmosDB) Stat(property string) (string, error) {
+	stat, ok := db.db.Stats()[property]
+	if !ok {
+		return "", fmt.Errorf("unknown database property %q", property)
+	}
+	return stat, nil
+}
+
+// Compact is a no-op, as cosmos-db backends compact themselves.
+func (db *cosmosDB) Compact([]byte, []byte) error {
+	return nil
+}
+
+// NewSnapshot returns an error, as cosmos-db does not support snapshots.
+func (db *cosmosDB) NewSnapshot() (ethdb.Snapshot, error) {
+	return nil, errCosmosDBSnapshot
+}
+
+// Close closes the database.
+func (db *cosmosDB) Close() error {
+	return db.db.Close()
+}
+
+// NewBatch returns a batch that writes to the database.
+func (db *cosmosDB) NewBatch() ethdb.Batch {
+	return &cosmosDBBatch{db: db.db}
+}
+
+// NewBatchWithSize returns a batch that writes to the database, pre-allocated for the given
+// number of bytes.
+func (db *cosmosDB) NewBatchWithSize(size int) ethdb.Batch {
+	return &cosmosDBBatch{db: db.db, size: size}
+}
+
+// NewIterator returns an iterator over the keys with the given prefix, starting at the given
+// key after the prefix.
+func (db *cosmosDB) NewIterator(prefix []byte, start []byte) ethdb.Iterator {
+	// cosmos-db rejects empty bounds, where nil iterates from the start or to the end of the
+	// database instead, which PrefixEndBytes returns for an empty prefix.
+	var from []byte
+	if len(prefix)+len(start) > 0 {
+		from = append(append([]byte{}, prefix...), start...)
+	}
+	it, err := db.db.Iterator(from, storetypes.PrefixEndBytes(prefix))
+	return &cosmosDBIterator{it: it, err: err}
+}
+
+// cosmosDBOp is a write of a cosmosDBBatch.
+type cosmosDBOp struct {
+	key    []byte
+	value  []byte
+	delete bool
+}
+
+// cosmosDBBatch is an ethdb.Batch that records its writes, as cosmos-db batches can neither be
+// reset nor replayed, and writes them to the database in a cosmos-db batch.
+type cosmosDBBatch struct {
+	db   dbm.DB
+	ops  []cosmosDBOp
+	size int
+}
+
+// Put records setting the value of the key.
+func (b *cosmosDBBatch) Put(key []byte, value []byte) error {
+	b.ops = append(b.ops, cosmosDBOp{
+		key: append([]byte{}, key...), value: append([]byte{}, value...),
+	})
+	b.size += len(key) + len(value)
+	return nil
+}
+
+// Delete records removing the key.
+func (b *cosmosDBBatch) Delete(key []byte) error {
+	b.ops = append(b.ops, cosmosDBOp{key: append([]byte{}, key...), delete: true})
+	b.size += len(key)
+	return nil
+}
+
+// ValueSize returns the size of the recorded writes.
+func (b *cosmosDBBatch) ValueSize() int {
+	return b.size
+}
+
+// Write writes the recorded writes to the database.
+func (b *cosmosDBBatch) Write() error {
+	batch := b.db.NewBatchWithSize(b.size)
+	defer batch.Close()
+	for _, op := range b.ops {
+		var err error
+		if op.delete {
+			err = batch.Delete(op.key)
+		} else {
+			err = batch.Set(op.key, op.value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
+// Reset discards the recorded writes.
+func (b *cosmosDBBatch) Reset() {
+	b.ops = b.ops[:0]
+	b.size = 0
+}
+
+// Replay replays the recorded writes to the given writer.
+func (b *cosmosDBBatch) Replay(w ethdb.KeyValueWriter) error {
+	for _, op := range b.ops {
+		var err error
+		if op.delete {
+			err = w.Delete(op.key)
+		} else {
+			err = w.Put(op.key, op.value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cosmosDBIterator is an ethdb.Iterator over a cosmos-db iterator, which unlike ethdb iterators
+// is positioned at its first key when it is created.
+type cosmosDBIterator struct {
+	it      dbm.Iterator
+	err     error
+	started bool
+}
+
+// Next moves the iterator to the next key, returning whether there is one.
+func (it *cosmosDBIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if it.started {
+		it.it.Next()
+	}
+	it.started = true
+	return it.it.Valid()
+}
+
+// Error returns the error of the iterator, if any.
+func (it *cosmosDBIterator) Error() error {
+	if it.err != nil {
+		return it.err
+	}
+	return it.it.Error()
+}
+
+// Key returns the key the iterator is at, or nil if it is exhausted.
+func (it *cosmosDBIterator) Key() []byte {
+	if !it.valid() {
+		return nil
+	}
+	return it.it.Key()
+}
+
+// Value returns the value the iterator is at, or nil if it is exhausted.
+func (it *cosmosDBIterator) Value() []byte {
+	if !it.valid() {
+		return nil
+	}
+	return it.it.Value()
+}
+
+// Release releases the iterator.
+func (it *cosmosDBIterator) Release() {
+	if it.it != nil {
+		_ = it.it.Close()
+	}
+}
+
+// valid returns whether the iterator is at a key.
+func (it *cosmosDBIterator) valid() bool {
+	return it.err == nil && it.started && it.it.Valid()
+}
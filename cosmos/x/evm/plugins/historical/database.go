@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package historical
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+	"github.com/berachain/polaris/eth/polar"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/leveldb"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+)
+
+const (
+	// BackendLevelDB stores historical data in a node-local leveldb database.
+	BackendLevelDB = "leveldb"
+	// BackendPebble stores historical data in a node-local pebble database, which requires the
+	// node to be built with the pebbledb build tag.
+	BackendPebble = "pebble"
+	// BackendMemory keeps historical data in memory, which is lost when the node stops and so
+	// is only meant for tests and ephemeral nodes.
+	BackendMemory = "memory"
+	// backendIAVL is the removed backend that stored historical data in the consensus store,
+	// which made the app hash depend on a node-local setting.
+	backendIAVL = "iavl"
+
+	// leveldbCache is the memory (in megabytes) allocated to the historical database cache.
+	leveldbCache = 256
+	// leveldbHandles is the number of files the historical database may keep open.
+	leveldbHandles = 256
+	// pebbleName is the name of the pebble database in the data directory.
+	pebbleName = "historical"
+)
+
+// historicalPrefixes are the prefixes of the keys the historical plugin writes, in the order
+// they are migrated.
+var historicalPrefixes = []byte{
+	types.BlockNumKeyToBlockPrefix,
+	types.BlockHashKeyToNumPrefix,
+	types.BlockHashKeyToReceiptsPrefix,
+	types.TxHashKeyToTxPrefix,
+	types.TxHashKeyToCosmosEventsPrefix,
+	types.BlobSidecarsPrefix,
+	types.VersionKey,
+}
+
+// Database is the key-value database that historical data is stored in.
+type Database interface {
+	ethdb.KeyValueReader
+	ethdb.KeyValueWriter
+}
+
+// OpenDatabase opens the node-local database configured for historical data. Only the memory
+// backend opens without a data directory.
+func OpenDatabase(cfg polar.HistoricalDBConfig) (ethdb.KeyValueStore, error) {
+	switch cfg.Backend {
+	case BackendMemory:
+		return memorydb.New(), nil
+	case BackendLevelDB, "":
+		if cfg.Datadir == "" {
+			return nil, errNoDatadir
+		}
+		return leveldb.New(cfg.Datadir, leveldbCache, leveldbHandles, "historical", false)
+	case BackendPebble:
+		if cfg.Datadir == "" {
+			return nil, errNoDatadir
+		}
+		// The polaris geth fork does not ship ethdb/pebble, so pebble is opened through
+		// cosmos-db, which registers it with the pebbledb build tag.
+		db, err := dbm.NewDB(pebbleName, dbm.PebbleDBBackend, cfg.Datadir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open the pebble historical database: %w", err)
+		}
+		return newCosmosDB(db), nil
+	case backendIAVL:
+		return nil, errConsensusBackend
+	default:
+		return nil, fmt.Errorf("unknown historical database backend %q", cfg.Backend)
+	}
+}
+
+// Migrate copies the historical data that chains used to store in the consensus store into the
+// given node-local database.
+// If prune is true, the copied entries are deleted from the consensus store, which changes the
+// app hash and so must only happen in consensus, e.g. in an upgrade handler. It returns the
+// number of migrated entries.
+func Migrate(store storetypes.KVStore, db ethdb.KeyValueWriter, prune bool) (int, error) {
+	var migrated int
+	for _, prefix := range historicalPrefixes {
+		var keys [][]byte
+		it := storetypes.KVStorePrefixIterator(store, []byte{prefix})
+		for ; it.Valid(); it.Next() {
+			if err := db.Put(it.Key(), it.Value()); err != nil {
+				it.Close()
+				return migrated, err
+			}
+			keys = append(keys, it.Key())
+			migrated++
+		}
+		if err := it.Close(); err != nil {
+			return migrated, err
+		}
+
+		if prune {
+			for _, key := range keys {
+				store.Delete(key)
+			}
+		}
+	}
+	return migrated, nil
+}
+
+// historicalKey returns the key of the given historical data type.
+func historicalKey(prefix byte, key []byte) []byte {
+	return append([]byte{prefix}, key...)
+}
@@ -27,4 +27,19 @@ import "errors"
 
 var (
 	ErrBlockNotFound = errors.New("block not found, is your node pruned?")
+
+	// errConsensusBackend is returned when historical data is configured to be stored in the
+	// consensus store, which is no longer supported.
+	errConsensusBackend = errors.New(
+		"historical data is node-local and can no longer be stored in the consensus store " +
+			"(\"iavl\"): set the backend to \"leveldb\" and copy the existing data with " +
+			"migrate-historical-db",
+	)
+
+	// errNoDatadir is returned when a persistent historical database has no data directory,
+	// rather than silently keeping historical data in memory.
+	errNoDatadir = errors.New(
+		"the historical database has no datadir: set datadir, or the backend to \"memory\" to " +
+			"keep historical data in memory",
+	)
 )
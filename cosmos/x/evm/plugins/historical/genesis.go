@@ -24,16 +24,31 @@
 package historical
 
 import (
+	"github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 func (p *plugin) InitGenesis(ctx sdk.Context, ethGen *core.Genesis) error {
+	return p.initGenesis(ctx, ethGen.ToBlock())
+}
+
+// InitGenesisStream is InitGenesis for a streamed genesis.
+func (p *plugin) InitGenesisStream(
+	ctx sdk.Context, header *types.GenesisStreamHeader, _ *types.GenesisStreamReader,
+) error {
+	return p.initGenesis(ctx, header.Block())
+}
+
+// initGenesis stores the given genesis block.
+func (p *plugin) initGenesis(ctx sdk.Context, block *ethtypes.Block) error {
 	p.Prepare(ctx)
 
 	// store genesis block
-	return p.StoreBlock(ethGen.ToBlock())
+	return p.StoreBlock(block)
 }
 
 func (p *plugin) ExportGenesis(_ sdk.Context, _ *core.Genesis) {}
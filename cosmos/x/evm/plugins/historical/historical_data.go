@@ -26,8 +26,6 @@ package historical
 import (
 	"fmt"
 
-	"cosmossdk.io/store/prefix"
-
 	"github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
 	coretypes "github.com/berachain/polaris/eth/core/types"
@@ -45,26 +43,23 @@ import (
 // StoreBlock implements `core.HistoricalPlugin`.
 func (p *plugin) StoreBlock(block *ethtypes.Block) error {
 	blockNum := block.NumberU64()
+	db := p.db()
 
 	// store block hash to block number.
 	numBz := sdk.Uint64ToBigEndian(blockNum)
-	store := p.ctx.MultiStore().GetKVStore(p.storeKey)
 
 	// store block num to block
 	blockBz, err := rlp.EncodeToBytes(block)
 	if err != nil {
 		return err
 	}
-	prefix.NewStore(store, []byte{types.BlockNumKeyToBlockPrefix}).
-		Set(numBz, blockBz)
-
-	// store block hash to block number.
-	prefix.NewStore(store, []byte{types.BlockHashKeyToNumPrefix}).
-		Set(block.Hash().Bytes(), numBz)
 
-	// store the version offchain for consistency.
-	offChainNum := sdk.BigEndianToUint64(store.Get([]byte{types.VersionKey}))
-	if blockNum > 0 && offChainNum != blockNum-1 {
+	// store the version offchain for consistency. The database may already contain the block if
+	// the node stopped before committing it, in which case it is overwritten. A database without
+	// a version, e.g. of a node started from a state sync snapshot, starts at any block.
+	versionBz, _ := db.Get([]byte{types.VersionKey})
+	offChainNum := sdk.BigEndianToUint64(versionBz)
+	if blockNum > 0 && versionBz != nil && offChainNum != blockNum-1 && offChainNum != blockNum {
 		panic(
 			fmt.Errorf(
 				"off-chain store's latest block number %d not synced with prev block number %d",
@@ -73,8 +68,26 @@ func (p *plugin) StoreBlock(block *ethtypes.Block) error {
 			),
 		)
 	}
-	store.Set([]byte{types.VersionKey}, numBz)
-	return nil
+
+	if err = db.Put(historicalKey(types.BlockNumKeyToBlockPrefix, numBz), blockBz); err != nil {
+		return err
+	}
+
+	// store block hash to block number.
+	if err = db.Put(
+		historicalKey(types.BlockHashKeyToNumPrefix, block.Hash().Bytes()), numBz,
+	); err != nil {
+		return err
+	}
+
+	// a database that starts after genesis has no earlier blocks to serve.
+	if versionBz == nil && blockNum > 0 {
+		if err = db.Put([]byte{types.EarliestBlockKey}, numBz); err != nil {
+			return err
+		}
+	}
+
+	return db.Put([]byte{types.VersionKey}, numBz)
 }
 
 // StoreReceipts implements `core.HistoricalPlugin`.
@@ -87,10 +100,9 @@ func (p *plugin) StoreReceipts(blockHash common.Hash, receipts ethtypes.Receipts
 		)
 		return err
 	}
-	prefix.NewStore(p.ctx.MultiStore().GetKVStore(p.storeKey),
-		[]byte{types.BlockHashKeyToReceiptsPrefix}).Set(blockHash.Bytes(), receiptsBz)
-
-	return nil
+	return p.db().Put(
+		historicalKey(types.BlockHashKeyToReceiptsPrefix, blockHash.Bytes()), receiptsBz,
+	)
 }
 
 // StoreTransactions implements `core.HistoricalPlugin`.
@@ -98,9 +110,7 @@ func (p *plugin) StoreTransactions(
 	blockNum uint64, blockHash common.Hash, txs ethtypes.Transactions,
 ) error {
 	// store all txns in the block.
-	txStore := prefix.NewStore(
-		p.ctx.MultiStore().GetKVStore(p.storeKey), []byte{types.TxHashKeyToTxPrefix},
-	)
+	db := p.db()
 	for txIndex, tx := range txs {
 		txLookupEntry := &coretypes.TxLookupEntry{
 			Tx:        tx,
@@ -117,17 +127,58 @@ func (p *plugin) StoreTransactions(
 			)
 			return err
 		}
-		txStore.Set(tx.Hash().Bytes(), tleBz)
+		if err = db.Put(
+			historicalKey(types.TxHashKeyToTxPrefix, tx.Hash().Bytes()), tleBz,
+		); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// StoreBlobSidecars implements `core.HistoricalPlugin`.
+func (p *plugin) StoreBlobSidecars(
+	blockNum uint64, sidecars []*ethtypes.BlobTxSidecar,
+) error {
+	db := p.db()
+
+	// prune the sidecars of the block that just fell out of the retention window.
+	if blockNum >= p.blobSidecarRetention {
+		if err := db.Delete(historicalKey(
+			types.BlobSidecarsPrefix, sdk.Uint64ToBigEndian(blockNum-p.blobSidecarRetention),
+		)); err != nil {
+			return err
+		}
+	}
+
+	if len(sidecars) == 0 {
+		return nil
+	}
+
+	// store block num to sidecars.
+	sidecarsBz, err := rlp.EncodeToBytes(sidecars)
+	if err != nil {
+		p.ctx.Logger().Error(
+			"UpdateOffChainStorage: failed to marshal blob sidecars", "block_number", blockNum,
+		)
+		return err
+	}
+	return db.Put(
+		historicalKey(types.BlobSidecarsPrefix, sdk.Uint64ToBigEndian(blockNum)), sidecarsBz,
+	)
+}
+
 // GetBlockByNumber returns the block at the given height.
 func (p *plugin) GetBlockByNumber(number uint64) (*ethtypes.Block, error) {
-	store := p.ctx.MultiStore().GetKVStore(p.storeKey)
-	numBz := sdk.Uint64ToBigEndian(number)
-	blockBz := prefix.NewStore(store, []byte{types.BlockNumKeyToBlockPrefix}).Get(numBz)
+	blockBz, _ := p.db().Get(
+		historicalKey(types.BlockNumKeyToBlockPrefix, sdk.Uint64ToBigEndian(number)),
+	)
+	if blockBz == nil {
+		if err := p.checkPruned(number); err != nil {
+			return nil, err
+		}
+	}
 	block := &ethtypes.Block{}
 	err := rlp.DecodeBytes(blockBz, block)
 	if err != nil {
@@ -138,29 +189,45 @@ func (p *plugin) GetBlockByNumber(number uint64) (*ethtypes.Block, error) {
 
 // GetBlockByHash returns the block at the given hash.
 func (p *plugin) GetBlockByHash(blockHash common.Hash) (*ethtypes.Block, error) {
-	store := p.ctx.MultiStore().GetKVStore(p.storeKey)
-	numBz := prefix.NewStore(
-		store, []byte{types.BlockHashKeyToNumPrefix}).Get(blockHash.Bytes())
+	db := p.db()
+	numBz, _ := db.Get(historicalKey(types.BlockHashKeyToNumPrefix, blockHash.Bytes()))
 	if numBz == nil {
 		return nil, core.ErrBlockNotFound
 	}
 
-	blockBz := prefix.NewStore(store, []byte{types.BlockNumKeyToBlockPrefix}).Get(numBz)
+	blockBz, _ := db.Get(historicalKey(types.BlockNumKeyToBlockPrefix, numBz))
+	if blockBz == nil {
+		if err := p.checkPruned(sdk.BigEndianToUint64(numBz)); err != nil {
+			return nil, err
+		}
+	}
 	block := &ethtypes.Block{}
 
 	err := rlp.DecodeBytes(blockBz, block)
 	if err != nil {
 		return nil, err
 	}
+
+	// An off-chain database may map the hash of a block that was never committed to the
+	// number of the block that was committed instead.
+	if block.Hash() != blockHash {
+		return nil, core.ErrBlockNotFound
+	}
 	return block, nil
 }
 
 // GetTransactionByHash returns the transaction lookup entry with the given hash.
 func (p *plugin) GetTransactionByHash(txHash common.Hash) (*coretypes.TxLookupEntry, error) {
 	// get tx from off chain.
-	tleBz := prefix.NewStore(
-		p.ctx.MultiStore().GetKVStore(p.storeKey), []byte{types.TxHashKeyToTxPrefix}).Get(txHash.Bytes())
+	db := p.db()
+	tleBz, _ := db.Get(historicalKey(types.TxHashKeyToTxPrefix, txHash.Bytes()))
 	if tleBz == nil {
+		// the numbers of the blocks of pruned transactions are kept.
+		if numBz, _ := db.Get(historicalKey(types.TxHashKeyToNumPrefix, txHash.Bytes())); numBz != nil {
+			if err := p.checkPruned(sdk.BigEndianToUint64(numBz)); err != nil {
+				return nil, err
+			}
+		}
 		return nil, core.ErrTxNotFound
 	}
 	tle := &coretypes.TxLookupEntry{}
@@ -171,12 +238,39 @@ func (p *plugin) GetTransactionByHash(txHash common.Hash) (*coretypes.TxLookupEn
 	return tle, nil
 }
 
+// GetBlobSidecarsByHash implements `core.HistoricalPlugin`. Returns no sidecars if the block does
+// not contain blob transactions or if its sidecars have been pruned.
+func (p *plugin) GetBlobSidecarsByHash(blockHash common.Hash) ([]*ethtypes.BlobTxSidecar, error) {
+	numBz, _ := p.db().Get(historicalKey(types.BlockHashKeyToNumPrefix, blockHash.Bytes()))
+	if numBz == nil {
+		return nil, core.ErrBlockNotFound
+	}
+
+	sidecarsBz, _ := p.db().Get(historicalKey(types.BlobSidecarsPrefix, numBz))
+	if sidecarsBz == nil {
+		return nil, nil
+	}
+	var sidecars []*ethtypes.BlobTxSidecar
+	if err := rlp.DecodeBytes(sidecarsBz, &sidecars); err != nil {
+		return nil, errorslib.Wrapf(
+			err, "failed to unmarshal blob sidecars for block hash %s", blockHash.Hex())
+	}
+	return sidecars, nil
+}
+
 // GetReceiptsByHash returns the receipts with the given block hash.
 func (p *plugin) GetReceiptsByHash(blockHash common.Hash) (ethtypes.Receipts, error) {
 	// get receipts from off chain.
-	receiptsBz := prefix.NewStore(p.ctx.MultiStore().GetKVStore(p.storeKey),
-		[]byte{types.BlockHashKeyToReceiptsPrefix}).Get(blockHash.Bytes())
+	receiptsBz, _ := p.db().Get(
+		historicalKey(types.BlockHashKeyToReceiptsPrefix, blockHash.Bytes()),
+	)
 	if receiptsBz == nil {
+		numBz, _ := p.db().Get(historicalKey(types.BlockHashKeyToNumPrefix, blockHash.Bytes()))
+		if numBz != nil {
+			if err := p.checkPruned(sdk.BigEndianToUint64(numBz)); err != nil {
+				return nil, err
+			}
+		}
 		return nil, fmt.Errorf("failed to find receipts for block hash %s", blockHash.Hex())
 	}
 	receipts, err := coretypes.UnmarshalReceipts(receiptsBz)
@@ -193,3 +287,15 @@ func (p *plugin) GetReceiptsByHash(blockHash common.Hash) (ethtypes.Receipts, er
 
 	return coretypes.DeriveReceiptsFromBlock(p.chainConfig, receipts, block)
 }
+
+// checkPruned returns an error wrapping core.ErrHistoryPruned if the historical data of the block
+// with the given number has been pruned. The genesis block is never pruned.
+func (p *plugin) checkPruned(number uint64) error {
+	if earliest := p.EarliestBlockNumber(); number > 0 && number < earliest {
+		return fmt.Errorf(
+			"%w: block %d is before the earliest available block %d",
+			core.ErrHistoryPruned, number, earliest,
+		)
+	}
+	return nil
+}
@@ -25,14 +25,20 @@ package historical
 
 import (
 	"context"
+	"time"
 
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/berachain/polaris/cosmos/x/evm/plugins"
 	"github.com/berachain/polaris/eth/core"
+	"github.com/berachain/polaris/eth/node"
+
+	abci "github.com/cometbft/cometbft/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -40,6 +46,17 @@ import (
 type Plugin interface {
 	core.HistoricalPlugin
 	plugins.HasGenesis
+	plugins.HasGenesisStream
+	node.Lifecycle
+	// MigrateToOffChainDB moves the historical data in the consensus store into the off-chain
+	// database, returning the number of migrated entries.
+	MigrateToOffChainDB(ctx sdk.Context) (int, error)
+	// StoreCosmosEvents stores the Cosmos events emitted during precompile execution by the
+	// transaction with the given hash.
+	StoreCosmosEvents(txHash common.Hash, events []abci.Event) error
+	// GetCosmosEventsByTxHash returns the Cosmos events emitted during precompile execution by
+	// the transaction with the given hash.
+	GetCosmosEventsByTxHash(txHash common.Hash) ([]abci.Event, error)
 }
 
 // plugin keeps track of polaris blocks via headers.
@@ -52,17 +69,30 @@ type plugin struct {
 	bp core.BlockPlugin
 	// storekey is the store key for the header store.
 	storeKey storetypes.StoreKey
+	// offChainDB is the node-local database historical data is stored in. Historical data is
+	// never stored in the consensus store, as how it is stored and retained is a per-node setting.
+	offChainDB ethdb.KeyValueStore
+	// blobSidecarRetention is the number of blocks for which blob sidecars are retained.
+	blobSidecarRetention uint64
+	// pruner prunes the historical data in the off-chain database, if a retention window is set.
+	pruner *pruner
 }
 
-// NewPlugin creates a new instance of the block plugin from the given context.
+// NewPlugin creates a new instance of the block plugin from the given context. Historical data
+// is stored in the node-local `offChainDB`, which is closed when the plugin stops. Blocks outside
+// of the `retainBlocks` or `retainAge` windows are pruned from it.
 func NewPlugin(
 	chainConfig *params.ChainConfig, bp core.BlockPlugin,
-	_ storetypes.StoreKey, storekey storetypes.StoreKey,
+	offChainDB ethdb.KeyValueStore, storekey storetypes.StoreKey, blobSidecarRetention uint64,
+	retainBlocks uint64, retainAge time.Duration,
 ) Plugin {
 	return &plugin{
-		chainConfig: chainConfig,
-		bp:          bp,
-		storeKey:    storekey,
+		chainConfig:          chainConfig,
+		bp:                   bp,
+		storeKey:             storekey,
+		offChainDB:           offChainDB,
+		pruner:               newPruner(offChainDB, retainBlocks, retainAge),
+		blobSidecarRetention: blobSidecarRetention,
 	}
 }
 
@@ -70,3 +100,34 @@ func NewPlugin(
 func (p *plugin) Prepare(ctx context.Context) {
 	p.ctx = sdk.UnwrapSDKContext(ctx)
 }
+
+// db returns the database historical data is stored in.
+func (p *plugin) db() Database {
+	return p.offChainDB
+}
+
+// Start implements node.Lifecycle, starting the pruner.
+func (p *plugin) Start() error {
+	if p.pruner != nil {
+		p.pruner.Start()
+	}
+	return nil
+}
+
+// Stop implements node.Lifecycle, stopping the pruner and closing the database.
+func (p *plugin) Stop() error {
+	if p.pruner != nil {
+		p.pruner.Stop()
+	}
+	return p.offChainDB.Close()
+}
+
+// EarliestBlockNumber implements core.HistoricalPlugin.
+func (p *plugin) EarliestBlockNumber() uint64 {
+	return earliestBlockNumber(p.offChainDB)
+}
+
+// MigrateToOffChainDB implements Plugin.
+func (p *plugin) MigrateToOffChainDB(ctx sdk.Context) (int, error) {
+	return Migrate(ctx.KVStore(p.storeKey), p.offChainDB, true)
+}
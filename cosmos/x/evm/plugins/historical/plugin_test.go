@@ -26,25 +26,37 @@ package historical
 import (
 	"math/big"
 	"testing"
+	"time"
 
 	"cosmossdk.io/log"
 
 	testutil "github.com/berachain/polaris/cosmos/testutil"
+	"github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
 	"github.com/berachain/polaris/eth/core/mock"
 	"github.com/berachain/polaris/eth/params"
+	"github.com/berachain/polaris/eth/polar"
 	"github.com/berachain/polaris/lib/utils"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
 	"github.com/ethereum/go-ethereum/trie"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
 
+const blobSidecarRetention = 2
+
 func TestHistoricalPlugin(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "cosmos/x/evm/plugins/historical")
@@ -62,7 +74,10 @@ var _ = Describe("Historical Data", func() {
 
 		genesis := core.DefaultGenesis
 		genesis.Config = params.DefaultChainConfig
-		p = utils.MustGetAs[*plugin](NewPlugin(params.DefaultChainConfig, bp, nil, testutil.EvmKey))
+		p = utils.MustGetAs[*plugin](NewPlugin(
+			params.DefaultChainConfig, bp, memorydb.New(), testutil.EvmKey, blobSidecarRetention,
+			0, 0,
+		))
 		Expect(p.InitGenesis(ctx, genesis)).To(Succeed())
 	})
 
@@ -130,6 +145,364 @@ var _ = Describe("Historical Data", func() {
 			Expect(tleByHash.BlockNum).To(Equal(uint64(1)))
 			Expect(tleByHash.Tx.Hash()).To(Equal(txHash))
 		})
+
+		It("should store blob sidecars and prune them after the retention window", func() {
+			ctx = ctx.WithBlockHeight(1)
+			p.Prepare(ctx)
+			block := ethtypes.NewBlockWithHeader(&ethtypes.Header{Number: big.NewInt(1)})
+			Expect(p.StoreBlock(block)).To(Succeed())
+
+			sidecars := []*ethtypes.BlobTxSidecar{{
+				Blobs:       []kzg4844.Blob{{0x1}},
+				Commitments: []kzg4844.Commitment{{0x2}},
+				Proofs:      []kzg4844.Proof{{0x3}},
+			}}
+			Expect(p.StoreBlobSidecars(1, sidecars)).To(Succeed())
+
+			stored, err := p.GetBlobSidecarsByHash(block.Hash())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stored).To(Equal(sidecars))
+
+			// Sidecars are node-local and never written to the consensus store.
+			Expect(ctx.KVStore(testutil.EvmKey).Has(
+				historicalKey(types.BlobSidecarsPrefix, sdk.Uint64ToBigEndian(1)),
+			)).To(BeFalse())
+
+			// Still within the retention window.
+			Expect(p.StoreBlobSidecars(2, nil)).To(Succeed())
+			stored, err = p.GetBlobSidecarsByHash(block.Hash())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stored).To(HaveLen(1))
+
+			// Block 1 falls out of the retention window.
+			Expect(p.StoreBlobSidecars(3, nil)).To(Succeed())
+			stored, err = p.GetBlobSidecarsByHash(block.Hash())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stored).To(BeEmpty())
+
+			_, err = p.GetBlobSidecarsByHash(common.Hash{0x1})
+			Expect(err).To(MatchError(core.ErrBlockNotFound))
+		})
+	})
+
+})
+
+var _ = Describe("Node-local Historical Data", func() {
+	var (
+		p          *plugin
+		ctx        sdk.Context
+		offChainDB *memorydb.Database
+		genesis    *core.Genesis
+	)
+
+	BeforeEach(func() {
+		ctx = testutil.NewContext(log.NewTestLogger(GinkgoT())).WithBlockHeight(0)
+		offChainDB = memorydb.New()
+		genesis = core.DefaultGenesis
+		genesis.Config = params.DefaultChainConfig
+		p = utils.MustGetAs[*plugin](NewPlugin(
+			params.DefaultChainConfig, mock.NewBlockPluginMock(), offChainDB, testutil.EvmKey,
+			blobSidecarRetention, 0, 0,
+		))
+	})
+
+	It("should store historical data off-chain", func() {
+		Expect(p.InitGenesis(ctx, genesis)).To(Succeed())
+		Expect(ctx.KVStore(testutil.EvmKey).Has([]byte{types.VersionKey})).To(BeFalse())
+		Expect(offChainDB.Len()).ToNot(BeZero())
+
+		block := ethtypes.NewBlockWithHeader(&ethtypes.Header{Number: big.NewInt(1)})
+		Expect(p.StoreBlock(block)).To(Succeed())
+		// A block that was stored but not committed before a restart is stored again.
+		Expect(p.StoreBlock(block)).To(Succeed())
+
+		stored, err := p.GetBlockByNumber(1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stored.Hash()).To(Equal(block.Hash()))
+
+		// A block that was replaced at the same height is not found by hash.
+		replaced := ethtypes.NewBlockWithHeader(&ethtypes.Header{Number: big.NewInt(1), Time: 1})
+		Expect(p.StoreBlock(replaced)).To(Succeed())
+		_, err = p.GetBlockByHash(block.Hash())
+		Expect(err).To(MatchError(core.ErrBlockNotFound))
+	})
+
+	It("should store the cosmos events of transactions", func() {
+		txs := ethtypes.Transactions{
+			ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 0}),
+			ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 1}),
+		}
+		Expect(p.StoreTransactions(1, common.Hash{1}, txs)).To(Succeed())
+		events := []abci.Event{{
+			Type:       "coin_spent",
+			Attributes: []abci.EventAttribute{{Key: "amount", Value: "1abera"}},
+		}}
+		Expect(p.StoreCosmosEvents(txs[0].Hash(), events)).To(Succeed())
+
+		stored, err := p.GetCosmosEventsByTxHash(txs[0].Hash())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stored).To(Equal(events))
+
+		stored, err = p.GetCosmosEventsByTxHash(txs[1].Hash())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stored).To(BeEmpty())
+
+		_, err = p.GetCosmosEventsByTxHash(common.Hash{2})
+		Expect(err).To(MatchError(core.ErrTxNotFound))
 	})
 
+	It("should migrate historical data out of the consensus store", func() {
+		// Seed the consensus store with the historical data older versions stored in it.
+		legacyDB := memorydb.New()
+		legacy := utils.MustGetAs[*plugin](NewPlugin(
+			params.DefaultChainConfig, mock.NewBlockPluginMock(), legacyDB, testutil.EvmKey,
+			blobSidecarRetention, 0, 0,
+		))
+		Expect(legacy.InitGenesis(ctx, genesis)).To(Succeed())
+		block := ethtypes.NewBlockWithHeader(&ethtypes.Header{Number: big.NewInt(1)})
+		Expect(legacy.StoreBlock(block)).To(Succeed())
+		it := legacyDB.NewIterator(nil, nil)
+		for it.Next() {
+			ctx.KVStore(testutil.EvmKey).Set(it.Key(), it.Value())
+		}
+		it.Release()
+
+		migrated, err := p.MigrateToOffChainDB(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(migrated).To(Equal(5))
+		Expect(ctx.KVStore(testutil.EvmKey).Has([]byte{types.VersionKey})).To(BeFalse())
+
+		p.Prepare(ctx)
+		stored, err := p.GetBlockByHash(block.Hash())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stored.Hash()).To(Equal(block.Hash()))
+		Expect(p.StoreBlock(
+			ethtypes.NewBlockWithHeader(&ethtypes.Header{Number: big.NewInt(2)}),
+		)).To(Succeed())
+	})
+
+	It("should start a database without a version at any block", func() {
+		Expect(p.StoreBlock(
+			ethtypes.NewBlockWithHeader(&ethtypes.Header{Number: big.NewInt(7)}),
+		)).To(Succeed())
+		Expect(func() {
+			_ = p.StoreBlock(ethtypes.NewBlockWithHeader(&ethtypes.Header{Number: big.NewInt(9)}))
+		}).To(Panic())
+	})
+
+	It("should prune a database that starts mid-chain", func() {
+		blocks := make([]*ethtypes.Block, 10)
+		for i := int64(5); i <= 9; i++ {
+			blocks[i] = ethtypes.NewBlockWithHeader(
+				&ethtypes.Header{Number: big.NewInt(i), Time: uint64(i * 10)},
+			)
+			Expect(p.StoreBlock(blocks[i])).To(Succeed())
+		}
+		Expect(p.EarliestBlockNumber()).To(Equal(uint64(5)))
+
+		// Databases started mid-chain before the earliest block was recorded are pruned too.
+		Expect(offChainDB.Delete([]byte{types.EarliestBlockKey})).To(Succeed())
+		Expect(p.EarliestBlockNumber()).To(BeZero())
+
+		pruned, err := newPruner(offChainDB, 2, 0).prune()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pruned).To(Equal(3))
+		Expect(p.EarliestBlockNumber()).To(Equal(uint64(8)))
+		_, err = p.GetBlockByHash(blocks[7].Hash())
+		Expect(err).To(MatchError(core.ErrHistoryPruned))
+		_, err = p.GetBlockByNumber(3)
+		Expect(err).To(MatchError(core.ErrHistoryPruned))
+		_, err = p.GetBlockByNumber(8)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should not store historical data in the consensus store", func() {
+		_, err := OpenDatabase(polar.HistoricalDBConfig{Backend: backendIAVL})
+		Expect(err).To(MatchError(errConsensusBackend))
+
+		db, err := OpenDatabase(polar.HistoricalDBConfig{Backend: BackendMemory})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(db).To(BeAssignableToTypeOf(&memorydb.Database{}))
+		Expect(db.Close()).To(Succeed())
+	})
+
+	It("should require a datadir to persist historical data", func() {
+		for _, backend := range []string{"", BackendLevelDB, BackendPebble} {
+			_, err := OpenDatabase(polar.HistoricalDBConfig{Backend: backend})
+			Expect(err).To(MatchError(errNoDatadir))
+		}
+
+		datadir := GinkgoT().TempDir()
+		db, err := OpenDatabase(polar.HistoricalDBConfig{Backend: BackendLevelDB, Datadir: datadir})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(db.Put([]byte{types.VersionKey}, []byte{1})).To(Succeed())
+		Expect(db.Close()).To(Succeed())
+
+		db, err = OpenDatabase(polar.HistoricalDBConfig{Backend: BackendLevelDB, Datadir: datadir})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(db.Get([]byte{types.VersionKey})).To(Equal([]byte{1}))
+		Expect(db.Close()).To(Succeed())
+
+		// Pebble is only registered in builds with the pebbledb build tag.
+		db, err = OpenDatabase(polar.HistoricalDBConfig{
+			Backend: BackendPebble, Datadir: GinkgoT().TempDir(),
+		})
+		if err != nil {
+			Expect(err).To(MatchError(ContainSubstring(string(dbm.PebbleDBBackend))))
+			return
+		}
+		Expect(db).To(BeAssignableToTypeOf(&cosmosDB{}))
+		Expect(db.Close()).To(Succeed())
+	})
+
+	It("should prune blocks outside of the retention window", func() {
+		Expect(p.InitGenesis(ctx, genesis)).To(Succeed())
+		blocks := make([]*ethtypes.Block, 6)
+		for i := int64(1); i <= 5; i++ {
+			tx := ethtypes.NewTransaction(
+				uint64(i), common.Address{0x1}, big.NewInt(1), 1000, big.NewInt(1), nil,
+			)
+			blocks[i] = ethtypes.NewBlock(
+				&ethtypes.Header{Number: big.NewInt(i), Time: uint64(i * 10)},
+				ethtypes.Transactions{tx}, nil, nil, trie.NewStackTrie(nil),
+			)
+			Expect(p.StoreBlock(blocks[i])).To(Succeed())
+			Expect(p.StoreTransactions(uint64(i), blocks[i].Hash(), blocks[i].Transactions())).
+				To(Succeed())
+			Expect(p.StoreReceipts(blocks[i].Hash(), ethtypes.Receipts{{}})).To(Succeed())
+		}
+		Expect(p.EarliestBlockNumber()).To(BeZero())
+
+		pr := newPruner(offChainDB, 2, 0)
+		pruned, err := pr.prune()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pruned).To(Equal(3))
+		Expect(p.EarliestBlockNumber()).To(Equal(uint64(4)))
+
+		// Lookups of the pruned blocks and transactions are reported as pruned.
+		_, err = p.GetBlockByHash(blocks[3].Hash())
+		Expect(err).To(MatchError(core.ErrHistoryPruned))
+		_, err = p.GetBlockByNumber(3)
+		Expect(err).To(MatchError(core.ErrHistoryPruned))
+		_, err = p.GetReceiptsByHash(blocks[3].Hash())
+		Expect(err).To(MatchError(core.ErrHistoryPruned))
+		_, err = p.GetTransactionByHash(blocks[3].Transactions()[0].Hash())
+		Expect(err).To(MatchError(core.ErrHistoryPruned))
+		_, err = p.GetCosmosEventsByTxHash(blocks[3].Transactions()[0].Hash())
+		Expect(err).To(MatchError(core.ErrHistoryPruned))
+		// Unknown blocks and transactions are not.
+		_, err = p.GetBlockByHash(common.Hash{0x1})
+		Expect(err).To(MatchError(core.ErrBlockNotFound))
+		_, err = p.GetTransactionByHash(common.Hash{0x1})
+		Expect(err).To(MatchError(core.ErrTxNotFound))
+		_, err = p.GetReceiptsByHash(blocks[4].Hash())
+		Expect(err).ToNot(HaveOccurred())
+		_, err = p.GetBlockByNumber(4)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = p.GetBlockByNumber(0)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Blocks older than the retention age are pruned, but never the latest block.
+		pr = newPruner(offChainDB, 0, time.Second)
+		pr.now = func() time.Time { return time.Unix(60, 0) }
+		pruned, err = pr.prune()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pruned).To(Equal(1))
+		Expect(p.EarliestBlockNumber()).To(Equal(uint64(5)))
+
+		Expect(newPruner(offChainDB, 0, 0)).To(BeNil())
+	})
+})
+
+var _ = Describe("cosmos-db Historical Database", func() {
+	var db ethdb.KeyValueStore
+
+	BeforeEach(func() {
+		db = newCosmosDB(dbm.NewMemDB())
+	})
+
+	It("should read and write keys", func() {
+		_, err := db.Get([]byte("a"))
+		Expect(err).To(MatchError(errCosmosDBNotFound))
+		Expect(db.Has([]byte("a"))).To(BeFalse())
+
+		Expect(db.Put([]byte("a"), []byte{1})).To(Succeed())
+		Expect(db.Get([]byte("a"))).To(Equal([]byte{1}))
+		Expect(db.Has([]byte("a"))).To(BeTrue())
+
+		Expect(db.Delete([]byte("a"))).To(Succeed())
+		Expect(db.Has([]byte("a"))).To(BeFalse())
+		Expect(db.Close()).To(Succeed())
+	})
+
+	It("should write, reset and replay batches", func() {
+		Expect(db.Put([]byte("b"), []byte{1})).To(Succeed())
+		batch := db.NewBatch()
+		Expect(batch.Put([]byte("a"), []byte{2})).To(Succeed())
+		Expect(batch.Delete([]byte("b"))).To(Succeed())
+		Expect(batch.ValueSize()).To(Equal(3))
+		Expect(db.Has([]byte("a"))).To(BeFalse())
+
+		Expect(batch.Write()).To(Succeed())
+		Expect(db.Get([]byte("a"))).To(Equal([]byte{2}))
+		Expect(db.Has([]byte("b"))).To(BeFalse())
+
+		replayed := memorydb.New()
+		Expect(replayed.Put([]byte("b"), []byte{1})).To(Succeed())
+		Expect(batch.Replay(replayed)).To(Succeed())
+		Expect(replayed.Get([]byte("a"))).To(Equal([]byte{2}))
+		Expect(replayed.Has([]byte("b"))).To(BeFalse())
+
+		batch.Reset()
+		Expect(batch.ValueSize()).To(BeZero())
+		Expect(batch.Put([]byte("c"), []byte{3})).To(Succeed())
+		Expect(batch.Write()).To(Succeed())
+		Expect(db.Get([]byte("c"))).To(Equal([]byte{3}))
+		Expect(db.Get([]byte("a"))).To(Equal([]byte{2}))
+	})
+
+	It("should iterate over a prefix from a start key", func() {
+		for _, key := range []string{"a", "b1", "b2", "b3", "c"} {
+			Expect(db.Put([]byte(key), []byte(key))).To(Succeed())
+		}
+		iterate := func(prefix, start string) []string {
+			var keys []string
+			it := db.NewIterator([]byte(prefix), []byte(start))
+			defer it.Release()
+			Expect(it.Key()).To(BeNil())
+			for it.Next() {
+				Expect(it.Value()).To(Equal(it.Key()))
+				keys = append(keys, string(it.Key()))
+			}
+			Expect(it.Error()).ToNot(HaveOccurred())
+			return keys
+		}
+
+		Expect(iterate("", "")).To(Equal([]string{"a", "b1", "b2", "b3", "c"}))
+		Expect(iterate("b", "")).To(Equal([]string{"b1", "b2", "b3"}))
+		Expect(iterate("b", "2")).To(Equal([]string{"b2", "b3"}))
+		Expect(iterate("d", "")).To(BeEmpty())
+	})
+
+	It("should store and prune historical data", func() {
+		p := utils.MustGetAs[*plugin](NewPlugin(
+			params.DefaultChainConfig, mock.NewBlockPluginMock(), db, testutil.EvmKey,
+			blobSidecarRetention, 0, 0,
+		))
+		blocks := make([]*ethtypes.Block, 6)
+		for i := int64(1); i <= 5; i++ {
+			blocks[i] = ethtypes.NewBlockWithHeader(&ethtypes.Header{Number: big.NewInt(i)})
+			Expect(p.StoreBlock(blocks[i])).To(Succeed())
+		}
+
+		pruned, err := newPruner(db, 2, 0).prune()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pruned).To(Equal(3))
+		Expect(p.EarliestBlockNumber()).To(Equal(uint64(4)))
+		_, err = p.GetBlockByNumber(3)
+		Expect(err).To(MatchError(core.ErrHistoryPruned))
+		stored, err := p.GetBlockByHash(blocks[5].Hash())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stored.Hash()).To(Equal(blocks[5].Hash()))
+	})
 })
@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package historical
+
+import (
+	"sync"
+	"time"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	// pruneInterval is the interval at which the pruner checks for blocks to prune.
+	pruneInterval = time.Minute
+	// pruneBatchLimit is the maximum number of blocks pruned at once, so that catching up with
+	// a newly configured retention window does not hog the database.
+	pruneBatchLimit = 1000
+)
+
+// pruner deletes the historical data of blocks that fall outside of the retention window from
+// the off-chain database. It keeps the numbers of the pruned blocks and transactions by hash, so
+// that their lookups are reported as pruned rather than unknown. It runs in the background,
+// outside of the consensus path.
+type pruner struct {
+	db           ethdb.KeyValueStore
+	retainBlocks uint64
+	retainAge    time.Duration
+	now          func() time.Time
+	logger       log.Logger
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// newPruner creates a new pruner. It returns nil if every block is retained.
+func newPruner(db ethdb.KeyValueStore, retainBlocks uint64, retainAge time.Duration) *pruner {
+	if db == nil || (retainBlocks == 0 && retainAge == 0) {
+		return nil
+	}
+	return &pruner{
+		db:           db,
+		retainBlocks: retainBlocks,
+		retainAge:    retainAge,
+		now:          time.Now,
+		logger:       log.Root().New("module", "historical-pruner"),
+		stopCh:       make(chan struct{}),
+	}
+}
+
+// Start starts pruning in the background.
+func (pr *pruner) Start() {
+	pr.wg.Add(1)
+	go pr.loop()
+}
+
+// Stop stops pruning and waits for the pruner to exit.
+func (pr *pruner) Stop() {
+	close(pr.stopCh)
+	pr.wg.Wait()
+}
+
+// loop periodically prunes blocks, catching up in batches.
+func (pr *pruner) loop() {
+	defer pr.wg.Done()
+	ticker := time.NewTicker(pruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-pr.stopCh:
+			return
+		case <-ticker.C:
+			for {
+				pruned, err := pr.prune()
+				if err != nil {
+					pr.logger.Error("failed to prune historical data", "err", err)
+				}
+				if pruned > 0 {
+					pr.logger.Info(
+						"pruned historical data", "blocks", pruned,
+						"earliest", earliestBlockNumber(pr.db),
+					)
+				}
+				if err != nil || pruned < pruneBatchLimit {
+					break
+				}
+			}
+		}
+	}
+}
+
+// prune deletes the historical data of up to pruneBatchLimit blocks that fall outside of the
+// retention window, returning the number of pruned blocks. The genesis and latest blocks are
+// never pruned.
+func (pr *pruner) prune() (int, error) {
+	versionBz, _ := pr.db.Get([]byte{types.VersionKey})
+	if versionBz == nil {
+		return 0, nil
+	}
+	latest := sdk.BigEndianToUint64(versionBz)
+
+	var pruned int
+	for num := max(earliestBlockNumber(pr.db), 1); num < latest && pruned < pruneBatchLimit; num++ {
+		numBz := sdk.Uint64ToBigEndian(num)
+		block := &ethtypes.Block{}
+		blockBz, _ := pr.db.Get(historicalKey(types.BlockNumKeyToBlockPrefix, numBz))
+		if blockBz == nil {
+			// the database starts after this block, e.g. if the node was started from a state
+			// sync snapshot, so skip to the first stored block.
+			next, ok := pr.nextStoredBlock(num)
+			if !ok {
+				break
+			}
+			if err := pr.db.Put(
+				[]byte{types.EarliestBlockKey}, sdk.Uint64ToBigEndian(next),
+			); err != nil {
+				return pruned, err
+			}
+			num = next - 1
+			continue
+		}
+		if err := rlp.DecodeBytes(blockBz, block); err != nil {
+			return pruned, err
+		}
+		if !pr.outsideRetention(num, latest, block.Time()) {
+			break
+		}
+
+		batch := pr.db.NewBatch()
+		for _, key := range [][]byte{
+			historicalKey(types.BlockNumKeyToBlockPrefix, numBz),
+			historicalKey(types.BlockHashKeyToReceiptsPrefix, block.Hash().Bytes()),
+			historicalKey(types.BlobSidecarsPrefix, numBz),
+		} {
+			if err := batch.Delete(key); err != nil {
+				return pruned, err
+			}
+		}
+		for _, tx := range block.Transactions() {
+			if err := batch.Delete(
+				historicalKey(types.TxHashKeyToTxPrefix, tx.Hash().Bytes()),
+			); err != nil {
+				return pruned, err
+			}
+			if err := batch.Delete(
+				historicalKey(types.TxHashKeyToCosmosEventsPrefix, tx.Hash().Bytes()),
+			); err != nil {
+				return pruned, err
+			}
+			if err := batch.Put(
+				historicalKey(types.TxHashKeyToNumPrefix, tx.Hash().Bytes()), numBz,
+			); err != nil {
+				return pruned, err
+			}
+		}
+		if err := batch.Put(
+			[]byte{types.EarliestBlockKey}, sdk.Uint64ToBigEndian(num+1),
+		); err != nil {
+			return pruned, err
+		}
+		if err := batch.Write(); err != nil {
+			return pruned, err
+		}
+		pruned++
+	}
+	return pruned, nil
+}
+
+// nextStoredBlock returns the number of the first block after the given one whose historical
+// data is stored, if any.
+func (pr *pruner) nextStoredBlock(num uint64) (uint64, bool) {
+	it := pr.db.NewIterator(
+		[]byte{types.BlockNumKeyToBlockPrefix}, sdk.Uint64ToBigEndian(num+1),
+	)
+	defer it.Release()
+	if !it.Next() {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(it.Key()[1:]), true
+}
+
+// outsideRetention returns whether the block with the given number and time falls outside of
+// the retention window, given the latest block number.
+func (pr *pruner) outsideRetention(num, latest, blockTime uint64) bool {
+	if pr.retainBlocks > 0 && latest-num >= pr.retainBlocks {
+		return true
+	}
+	if pr.retainAge > 0 {
+		age := pr.now().Sub(time.Unix(int64(blockTime), 0))
+		return age > pr.retainAge
+	}
+	return false
+}
+
+// earliestBlockNumber returns the number of the earliest block whose historical data has not been
+// pruned from the given database.
+func earliestBlockNumber(db Database) uint64 {
+	earliestBz, _ := db.Get([]byte{types.EarliestBlockKey})
+	return sdk.BigEndianToUint64(earliestBz)
+}
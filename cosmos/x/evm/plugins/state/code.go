@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package state
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Contract code is stored once per code hash under CodeKeyFor, and shared by every account with
+// that code hash. The number of accounts referencing it is kept under CodeRefCountKeyFor, and the
+// code is garbage collected once no account references it. The size of the code is indexed under
+// CodeSizeKeyFor, so that EXTCODESIZE does not load the full code.
+
+// retainCode adds a reference to the given code, storing the code and its size if it is not
+// referenced yet.
+func retainCode(store storetypes.KVStore, codeHash common.Hash, code []byte) {
+	refs := codeRefCount(store, codeHash)
+	if refs == 0 {
+		store.Set(CodeKeyFor(codeHash), code)
+		store.Set(CodeSizeKeyFor(codeHash), sdk.Uint64ToBigEndian(uint64(len(code))))
+	}
+	store.Set(CodeRefCountKeyFor(codeHash), sdk.Uint64ToBigEndian(refs+1))
+}
+
+// releaseCode removes a reference to the code with the given code hash, deleting the code and
+// its size if it is no longer referenced. The empty code is not stored, so it is ignored.
+func releaseCode(store storetypes.KVStore, codeHash common.Hash) {
+	if (codeHash == common.Hash{}) || codeHash == emptyCodeHash {
+		return
+	}
+	if refs := codeRefCount(store, codeHash); refs > 1 {
+		store.Set(CodeRefCountKeyFor(codeHash), sdk.Uint64ToBigEndian(refs-1))
+		return
+	}
+	store.Delete(CodeKeyFor(codeHash))
+	store.Delete(CodeSizeKeyFor(codeHash))
+	store.Delete(CodeRefCountKeyFor(codeHash))
+}
+
+// codeRefCount returns the number of accounts referencing the code with the given code hash.
+func codeRefCount(store storetypes.KVStore, codeHash common.Hash) uint64 {
+	bz := store.Get(CodeRefCountKeyFor(codeHash))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// codeSize returns the size of the code with the given code hash, falling back to the length of
+// the code if its size is not indexed.
+func codeSize(store storetypes.KVStore, codeHash common.Hash) int {
+	if bz := store.Get(CodeSizeKeyFor(codeHash)); bz != nil {
+		return int(sdk.BigEndianToUint64(bz))
+	}
+	return len(store.Get(CodeKeyFor(codeHash)))
+}
+
+// countCodeRefs returns the number of accounts referencing each non-empty code hash.
+func countCodeRefs(store storetypes.KVStore) (map[common.Hash]uint64, error) {
+	refs := make(map[common.Hash]uint64)
+	it := storetypes.KVStorePrefixIterator(store, []byte{types.CodeHashKeyPrefix})
+	for ; it.Valid(); it.Next() {
+		if codeHash := common.BytesToHash(it.Value()); codeHash != emptyCodeHash {
+			refs[codeHash]++
+		}
+	}
+	return refs, it.Close()
+}
+
+// codeHashesWithPrefix returns the code hashes of the keys with the given prefix.
+func codeHashesWithPrefix(store storetypes.KVStore, prefix byte) ([]common.Hash, error) {
+	var codeHashes []common.Hash
+	it := storetypes.KVStorePrefixIterator(store, []byte{prefix})
+	for ; it.Valid(); it.Next() {
+		codeHashes = append(codeHashes, CodeHashFromCodeKey(it.Key()))
+	}
+	return codeHashes, it.Close()
+}
+
+// RebuildCodeIndex recounts the references to every stored code and reindexes its size,
+// deleting the code that no account references. It is used to migrate stores written before
+// code was reference counted, and returns the number of codes deleted.
+func RebuildCodeIndex(store storetypes.KVStore) (int, error) {
+	refs, err := countCodeRefs(store)
+	if err != nil {
+		return 0, err
+	}
+	// Drop the existing index, which is rebuilt below for the codes that are referenced.
+	for prefix, keyFor := range map[byte]func(common.Hash) []byte{
+		types.CodeRefCountKeyPrefix: CodeRefCountKeyFor, types.CodeSizeKeyPrefix: CodeSizeKeyFor,
+	} {
+		var indexed []common.Hash
+		if indexed, err = codeHashesWithPrefix(store, prefix); err != nil {
+			return 0, err
+		}
+		for _, codeHash := range indexed {
+			store.Delete(keyFor(codeHash))
+		}
+	}
+
+	codeHashes, err := codeHashesWithPrefix(store, types.CodeKeyPrefix)
+	if err != nil {
+		return 0, err
+	}
+	var deleted int
+	for _, codeHash := range codeHashes {
+		count, ok := refs[codeHash]
+		if !ok {
+			store.Delete(CodeKeyFor(codeHash))
+			deleted++
+			continue
+		}
+		store.Set(CodeRefCountKeyFor(codeHash), sdk.Uint64ToBigEndian(count))
+		store.Set(CodeSizeKeyFor(codeHash), sdk.Uint64ToBigEndian(
+			uint64(len(store.Get(CodeKeyFor(codeHash)))),
+		))
+	}
+	return deleted, nil
+}
+
+// CheckCodeIndex verifies that every stored code is referenced by as many accounts as its
+// reference count, that its size is indexed correctly, and that the code of every account is
+// stored. It returns every violation found, joined together.
+func CheckCodeIndex(store storetypes.KVStore) error {
+	refs, err := countCodeRefs(store)
+	if err != nil {
+		return err
+	}
+
+	// Check the code hashes in order, so that the violations are reported deterministically.
+	referenced := maps.Keys(refs)
+	slices.SortFunc(referenced, func(a, b common.Hash) int { return a.Cmp(b) })
+	var errs []error
+	for _, codeHash := range referenced {
+		count := refs[codeHash]
+		code := store.Get(CodeKeyFor(codeHash))
+		if code == nil {
+			errs = append(errs, fmt.Errorf("code %s referenced by %d accounts is missing",
+				codeHash, count))
+			continue
+		}
+		if stored := codeRefCount(store, codeHash); stored != count {
+			errs = append(errs, fmt.Errorf("code %s has ref count %d, but %d references",
+				codeHash, stored, count))
+		}
+		if size := codeSize(store, codeHash); size != len(code) {
+			errs = append(errs, fmt.Errorf("code %s has indexed size %d, but size %d",
+				codeHash, size, len(code)))
+		}
+	}
+
+	codeHashes, err := codeHashesWithPrefix(store, types.CodeKeyPrefix)
+	if err != nil {
+		return err
+	}
+	for _, codeHash := range codeHashes {
+		if _, ok := refs[codeHash]; !ok {
+			errs = append(errs, fmt.Errorf("code %s is not referenced by any account", codeHash))
+		}
+	}
+	return errors.Join(errs...)
+}
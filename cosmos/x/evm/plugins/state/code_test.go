@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package state_test
+
+import (
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	testutil "github.com/berachain/polaris/cosmos/testutil"
+	"github.com/berachain/polaris/cosmos/x/evm/plugins/state"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Code Index", func() {
+	var store storetypes.KVStore
+	code := []byte("code")
+	codeHash := crypto.Keccak256Hash(code)
+
+	BeforeEach(func() {
+		ctx, _, _, _ := testutil.SetupMinimalKeepers(log.NewTestLogger(GinkgoT()))
+		store = ctx.MultiStore().GetKVStore(testutil.EvmKey)
+	})
+
+	It("should rebuild the index of code stored without ref counts", func() {
+		orphan := []byte("orphan")
+		store.Set(state.CodeHashKeyFor(alice), codeHash[:])
+		store.Set(state.CodeHashKeyFor(bob), codeHash[:])
+		store.Set(state.CodeKeyFor(codeHash), code)
+		store.Set(state.CodeKeyFor(crypto.Keccak256Hash(orphan)), orphan)
+		Expect(state.CheckCodeIndex(store)).ToNot(Succeed())
+
+		deleted, err := state.RebuildCodeIndex(store)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(Equal(1))
+		Expect(store.Get(state.CodeKeyFor(crypto.Keccak256Hash(orphan)))).To(BeNil())
+		Expect(state.CheckCodeIndex(store)).To(Succeed())
+	})
+
+	It("should report missing code", func() {
+		store.Set(state.CodeHashKeyFor(alice), codeHash[:])
+
+		Expect(state.CheckCodeIndex(store)).To(MatchError(ContainSubstring("is missing")))
+	})
+})
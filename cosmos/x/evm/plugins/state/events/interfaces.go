@@ -42,5 +42,7 @@ type (
 	LogsDB interface {
 		// AddLog adds a log to the database.
 		AddLog(*ethtypes.Log)
+		// TxIndex returns the index of the transaction that is currently being executed.
+		TxIndex() int
 	}
 )
@@ -55,6 +55,19 @@ type manager struct {
 	plf PrecompileLogFactory
 	// readOnly is true if the EVM is in read-only mode
 	readOnly bool
+	// precompileEvents are all the Cosmos events emitted during precompile execution, whether or
+	// not they are converted to Eth logs.
+	precompileEvents []precompileEvent
+}
+
+// precompileEvent is a Cosmos event emitted during precompile execution.
+type precompileEvent struct {
+	// txIndex is the index of the transaction that emitted the event.
+	txIndex int
+	// pos is the position of the event in the underlying Cosmos event manager, used to revert.
+	pos int
+	// event is the emitted Cosmos event.
+	event sdk.Event
 }
 
 // NewManager creates and returns a controllable event manager from the given Cosmos SDK context.
@@ -101,6 +114,7 @@ func (m *manager) EmitEvent(event sdk.Event) {
 		if m.readOnly {
 			panic(vm.ErrWriteProtection)
 		}
+		m.recordPrecompileEvents(sdk.Events{event})
 		m.convertToLog(&event)
 	}
 }
@@ -115,6 +129,7 @@ func (m *manager) EmitEvents(events sdk.Events) {
 		if m.readOnly {
 			panic(vm.ErrWriteProtection)
 		}
+		m.recordPrecompileEvents(events)
 		for i := range events {
 			m.convertToLog(&events[i])
 		}
@@ -135,6 +150,9 @@ func (m *manager) Snapshot() int {
 func (m *manager) RevertToSnapshot(id int) {
 	// only the events up to the snapshot id are remaining
 	remaining := m.Events()[:id]
+	for len(m.precompileEvents) > 0 && m.precompileEvents[len(m.precompileEvents)-1].pos >= id {
+		m.precompileEvents = m.precompileEvents[:len(m.precompileEvents)-1]
+	}
 
 	// modify the EventManager on the underlying Cosmos SDK context
 	*m.EventManager = *sdk.NewEventManager()
@@ -147,6 +165,29 @@ func (m *manager) RevertToSnapshot(id int) {
 // Finalize implements `libtypes.Finalizable`.
 func (m *manager) Finalize() {}
 
+// PrecompileEvents returns all the Cosmos events emitted during precompile execution, keyed by
+// the index of the transaction that emitted them.
+func (m *manager) PrecompileEvents() map[int]sdk.Events {
+	events := make(map[int]sdk.Events)
+	for _, pe := range m.precompileEvents {
+		events[pe.txIndex] = append(events[pe.txIndex], pe.event)
+	}
+	return events
+}
+
+// recordPrecompileEvents records the given events, which were just emitted during precompile
+// execution, under the current transaction.
+func (m *manager) recordPrecompileEvents(events sdk.Events) {
+	txIndex, pos := m.ldb.TxIndex(), len(m.Events())-len(events)
+	for i, event := range events {
+		m.precompileEvents = append(m.precompileEvents, precompileEvent{
+			txIndex: txIndex,
+			pos:     pos + i,
+			event:   event,
+		})
+	}
+}
+
 // convertToLog builds an Eth log from the given Cosmos event and adds it to the logs journal.
 func (m *manager) convertToLog(event *sdk.Event) {
 	log, err := m.plf.Build(event)
@@ -102,4 +102,27 @@ var _ = Describe("Manager", func() {
 
 		Expect(func() { cem.Finalize() }).ToNot(Panic())
 	})
+
+	It("should record all cosmos events emitted during precompile per tx", func() {
+		ctx.EventManager().EmitEvent(sdk.NewEvent("not-in-precompile"))
+
+		cem.BeginPrecompileExecution(ldb)
+		ctx.EventManager().EmitEvent(sdk.NewEvent("2"))
+		ldb.TxIndexFunc = func() int { return 1 }
+		snap := cem.Snapshot()
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent("3"),
+			sdk.NewEvent("4"),
+		})
+		Expect(cem.PrecompileEvents()).To(HaveLen(2))
+		Expect(cem.PrecompileEvents()[1]).To(HaveLen(2))
+
+		cem.RevertToSnapshot(snap)
+		ctx.EventManager().EmitEvent(sdk.NewEvent("5"))
+		cem.EndPrecompileExecution()
+
+		events := cem.PrecompileEvents()
+		Expect(events[0]).To(Equal(sdk.Events{sdk.NewEvent("2")}))
+		Expect(events[1]).To(Equal(sdk.Events{sdk.NewEvent("5")}))
+	})
 })
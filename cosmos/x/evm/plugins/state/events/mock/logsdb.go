@@ -31,6 +31,7 @@ import (
 
 func NewEmptyLogsDB() *LogsDBMock {
 	return &LogsDBMock{
-		AddLogFunc: func(log *ethtypes.Log) {},
+		AddLogFunc:  func(log *ethtypes.Log) {},
+		TxIndexFunc: func() int { return 0 },
 	}
 }
@@ -22,6 +22,9 @@ var _ events.LogsDB = &LogsDBMock{}
 //			AddLogFunc: func(log *ethtypes.Log)  {
 //				panic("mock out the AddLog method")
 //			},
+//			TxIndexFunc: func() int {
+//				panic("mock out the TxIndex method")
+//			},
 //		}
 //
 //		// use mockedLogsDB in code that requires events.LogsDB
@@ -32,6 +35,9 @@ type LogsDBMock struct {
 	// AddLogFunc mocks the AddLog method.
 	AddLogFunc func(log *ethtypes.Log)
 
+	// TxIndexFunc mocks the TxIndex method.
+	TxIndexFunc func() int
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// AddLog holds details about calls to the AddLog method.
@@ -39,8 +45,12 @@ type LogsDBMock struct {
 			// Log is the log argument value.
 			Log *ethtypes.Log
 		}
+		// TxIndex holds details about calls to the TxIndex method.
+		TxIndex []struct {
+		}
 	}
-	lockAddLog sync.RWMutex
+	lockAddLog  sync.RWMutex
+	lockTxIndex sync.RWMutex
 }
 
 // AddLog calls AddLogFunc.
@@ -74,3 +84,30 @@ func (mock *LogsDBMock) AddLogCalls() []struct {
 	mock.lockAddLog.RUnlock()
 	return calls
 }
+
+// TxIndex calls TxIndexFunc.
+func (mock *LogsDBMock) TxIndex() int {
+	if mock.TxIndexFunc == nil {
+		panic("LogsDBMock.TxIndexFunc: method is nil but LogsDB.TxIndex was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockTxIndex.Lock()
+	mock.calls.TxIndex = append(mock.calls.TxIndex, callInfo)
+	mock.lockTxIndex.Unlock()
+	return mock.TxIndexFunc()
+}
+
+// TxIndexCalls gets all the calls that were made to TxIndex.
+// Check the length with:
+//
+//	len(mockedLogsDB.TxIndexCalls())
+func (mock *LogsDBMock) TxIndexCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockTxIndex.RLock()
+	calls = mock.calls.TxIndex
+	mock.lockTxIndex.RUnlock()
+	return calls
+}
@@ -25,6 +25,8 @@ package state
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -50,6 +52,12 @@ type SPFactory struct {
 	finalizeBlockContext sdk.Context // "finalize" --> set in Finalize
 	latestQueryContext   sdk.Context // "latest" ----> set in PrepareCheckState
 
+	// finalizePlugin is the state plugin that the block being finalized is written with.
+	finalizePlugin Plugin
+
+	// versions reports the versions of the evm store that are retained, i.e. not pruned.
+	versions versionStore
+
 	// Query function for getting the context at a given height.
 	qfn func() func(height int64, prove bool) (sdk.Context, error) // "historical"
 }
@@ -81,6 +89,7 @@ func (spf *SPFactory) NewPluginWithMode(mode state.Mode) core.StatePlugin {
 		p.Reset(spf.insertChainContext)
 	case state.Finalize:
 		p.Reset(spf.finalizeBlockContext)
+		spf.finalizePlugin = p
 	case state.Latest:
 		fallthrough
 	default:
@@ -109,9 +118,13 @@ func (spf *SPFactory) NewPluginAtBlockNumber(blockNumber int64) (core.StatePlugi
 	if blockNumber >= spf.latestQueryContext.BlockHeight() {
 		ctx, _ = spf.latestQueryContext.CacheContext()
 	} else {
-		// Get the query context at the given height.
-		ctx, err = spf.qfn()(blockNumber, false)
-		if err != nil {
+		// The state at the given height is only available if the version of the evm store has
+		// not been pruned, which is always the case if the node runs in archive mode.
+		if blockNumber > 0 && spf.versions != nil && !spf.versions.VersionExists(blockNumber) {
+			return nil, fmt.Errorf("%w: block %d is before the earliest available state %d",
+				core.ErrStatePruned, blockNumber, spf.earliestVersion())
+		}
+		if ctx, err = spf.qfn()(blockNumber, false); err != nil {
 			return nil, err
 		}
 	}
@@ -119,6 +132,26 @@ func (spf *SPFactory) NewPluginAtBlockNumber(blockNumber int64) (core.StatePlugi
 	return spf.NewPluginFromContext(ctx), nil
 }
 
+// versionStore is a committed store that reports whether its versions are retained, e.g. IAVL.
+type versionStore interface {
+	VersionExists(version int64) bool
+}
+
+// SetCommitMultiStore sets the multistore that the evm store is committed to, whose retained
+// versions are the heights at which the historical state is available.
+func (spf *SPFactory) SetCommitMultiStore(cms storetypes.CommitMultiStore) {
+	spf.versions, _ = cms.GetCommitKVStore(spf.storeKey).(versionStore)
+}
+
+// earliestVersion returns the earliest version of the evm store that is retained. As versions are
+// pruned from the earliest one on, the retained versions are the ones up to the latest height.
+func (spf *SPFactory) earliestVersion() int64 {
+	latest := spf.latestQueryContext.BlockHeight()
+	return int64(sort.Search(int(latest), func(i int) bool {
+		return spf.versions.VersionExists(int64(i) + 1)
+	})) + 1
+}
+
 // SetGenesisContext updates the SPFactory's genesis context to the provided context.
 func (spf *SPFactory) SetGenesisContext(ctx context.Context) {
 	spf.genesisContext = sdk.UnwrapSDKContext(ctx)
@@ -134,9 +167,19 @@ func (spf *SPFactory) SetInsertChainContext(ctx context.Context) {
 	spf.insertChainContext = sdk.UnwrapSDKContext(ctx)
 }
 
+// FinalizedPrecompileEvents returns the Cosmos events emitted during precompile execution by the
+// transactions of the block that was last finalized, keyed by transaction index.
+func (spf *SPFactory) FinalizedPrecompileEvents() map[int]sdk.Events {
+	if spf.finalizePlugin == nil {
+		return nil
+	}
+	return spf.finalizePlugin.PrecompileEvents()
+}
+
 // SetFinalizeBlockContext updates the SPFactory's finalizeBlockContext to the provided context.
 func (spf *SPFactory) SetFinalizeBlockContext(ctx context.Context) {
 	spf.finalizeBlockContext = sdk.UnwrapSDKContext(ctx)
+	spf.finalizePlugin = nil
 }
 
 // SetLatestQueryContext updates the SPFactory's latestQueryContext to the provided context.
@@ -148,6 +191,17 @@ func (spf *SPFactory) SetLatestQueryContext(ctx context.Context) {
 	spf.latestQueryContext = sdk.UnwrapSDKContext(ctx)
 }
 
+// ContextHeights returns the block heights of the contexts that state plugins are created with,
+// keyed by the name of their mode.
+func (spf *SPFactory) ContextHeights() map[string]int64 {
+	return map[string]int64{
+		"miner":    spf.minerBuildContext.BlockHeight(),
+		"insert":   spf.insertChainContext.BlockHeight(),
+		"finalize": spf.finalizeBlockContext.BlockHeight(),
+		"latest":   spf.latestQueryContext.BlockHeight(),
+	}
+}
+
 // SetPrecompileLogFactory sets the PrecompileLogFactory in the SPFactory.
 func (spf *SPFactory) SetPrecompileLogFactory(plf events.PrecompileLogFactory) {
 	spf.plf = plf
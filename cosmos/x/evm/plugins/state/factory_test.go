@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package state_test
+
+import (
+	"errors"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	testutil "github.com/berachain/polaris/cosmos/testutil"
+	"github.com/berachain/polaris/cosmos/x/evm/plugins/state"
+	"github.com/berachain/polaris/eth/core"
+
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// multiStore is a multistore that only holds the evm store.
+type multiStore struct {
+	storetypes.CommitMultiStore
+	evm storetypes.CommitKVStore
+}
+
+func (ms *multiStore) GetCommitKVStore(storetypes.StoreKey) storetypes.CommitKVStore {
+	return ms.evm
+}
+
+// retainedStore is a store that retains the versions from the earliest one on.
+type retainedStore struct {
+	storetypes.CommitKVStore
+	earliest int64
+}
+
+func (rs *retainedStore) VersionExists(version int64) bool {
+	return version >= rs.earliest
+}
+
+var _ = Describe("State Plugin Factory", func() {
+	var ctx sdk.Context
+	var spf *state.SPFactory
+	var queried []int64
+
+	BeforeEach(func() {
+		var ak state.AccountKeeper
+		ctx, ak, _, _ = testutil.SetupMinimalKeepers(log.NewTestLogger(GinkgoT()))
+		ctx = ctx.WithBlockHeight(10)
+		queried = nil
+		spf = state.NewSPFactory(ak, testutil.EvmKey,
+			func() func(height int64, prove bool) (sdk.Context, error) {
+				return func(height int64, _ bool) (sdk.Context, error) {
+					queried = append(queried, height)
+					if height == 6 {
+						return sdk.Context{}, errors.New("failed to load state at height")
+					}
+					return ctx.WithBlockHeight(height), nil
+				}
+			},
+		)
+		spf.SetLatestQueryContext(ctx)
+		spf.SetCommitMultiStore(&multiStore{evm: &retainedStore{earliest: 5}})
+	})
+
+	It("should use the latest context at or above the latest height", func() {
+		_, err := spf.NewPluginAtBlockNumber(10)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = spf.NewPluginAtBlockNumber(11)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(queried).To(BeEmpty())
+	})
+
+	It("should query retained historical heights", func() {
+		_, err := spf.NewPluginAtBlockNumber(7)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(queried).To(Equal([]int64{7}))
+	})
+
+	It("should report pruned historical heights", func() {
+		_, err := spf.NewPluginAtBlockNumber(3)
+		Expect(err).To(MatchError(core.ErrStatePruned))
+		Expect(err).To(MatchError(ContainSubstring("earliest available state 5")))
+		_, err = spf.NewPluginAtBlockNumber(4)
+		Expect(err).To(MatchError(core.ErrStatePruned))
+		Expect(queried).To(BeEmpty())
+
+		_, err = spf.NewPluginAtBlockNumber(5)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(queried).To(Equal([]int64{5}))
+	})
+
+	It("should report the heights pruned from the evm store", func() {
+		cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+		cms.MountStoreWithDB(testutil.EvmKey, storetypes.StoreTypeIAVL, nil)
+		cms.SetPruning(pruningtypes.NewCustomPruningOptions(3, 1))
+		Expect(cms.LoadLatestVersion()).To(Succeed())
+		for i := 0; i < 10; i++ {
+			cms.GetKVStore(testutil.EvmKey).Set([]byte("height"), []byte{byte(i)})
+			cms.Commit()
+		}
+		spf.SetCommitMultiStore(cms)
+
+		// The latest version and the 3 before it are kept.
+		_, err := spf.NewPluginAtBlockNumber(5)
+		Expect(err).To(MatchError(core.ErrStatePruned))
+		Expect(err).To(MatchError(ContainSubstring("earliest available state 7")))
+		_, err = spf.NewPluginAtBlockNumber(7)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(queried).To(Equal([]int64{7}))
+	})
+
+	It("should not report pruned heights without a versioned store", func() {
+		spf.SetCommitMultiStore(&multiStore{})
+		_, err := spf.NewPluginAtBlockNumber(3)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(queried).To(Equal([]int64{3}))
+	})
+
+	It("should pass through other errors at historical heights", func() {
+		_, err := spf.NewPluginAtBlockNumber(6)
+		Expect(err).To(MatchError("failed to load state at height"))
+		Expect(err).ToNot(MatchError(core.ErrStatePruned))
+	})
+
+	It("should report the heights of its contexts", func() {
+		spf.SetLatestMiningContext(ctx.WithBlockHeight(11))
+		spf.SetInsertChainContext(ctx.WithBlockHeight(11))
+
+		Expect(spf.ContextHeights()).To(Equal(map[string]int64{
+			"miner": 11, "insert": 11, "finalize": 0, "latest": 10,
+		}))
+	})
+})
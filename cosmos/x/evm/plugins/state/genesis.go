@@ -24,11 +24,16 @@
 package state
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"math/big"
 
 	"golang.org/x/exp/slices"
 
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -50,96 +55,174 @@ func (p *plugin) InitGenesis(ctx sdk.Context, ethGen *core.Genesis) error {
 	// Iterate over the sorted genesis accounts and set nonces, balances, codes, and storage.
 	for _, address := range sortedAddresses {
 		account := ethGen.Alloc[address]
-
-		// Initialize the account on the auth keeper.
-		// NOTE: The auth module's init genesis runs before the evm module's init genesis.
-		if p.Exist(address) {
-			// If the account exists on the auth keeper, ensure the nonce is consistent.
-			if p.GetNonce(address) != account.Nonce {
-				return fmt.Errorf(
-					"account nonce mismatch for (%s) between auth (%d) and evm (%d) genesis state",
-					address.Hex(), p.GetNonce(address), account.Nonce,
-				)
-			}
-		} else {
-			p.CreateAccount(address)
-			p.SetNonce(address, account.Nonce)
+		if err := p.initGenesisAccount(address, &account); err != nil {
+			return err
 		}
+	}
 
-		// Initialize the account data on the state plugin.
-		if account.Balance != nil {
-			p.SetBalance(address, account.Balance)
+	p.Finalize()
+	return nil
+}
+
+// InitGenesisStream populates the KV store with the alloc records read from the given streamed
+// genesis, whose header is already read. The state is finalized after every record so that only
+// a single record is held in memory.
+func (p *plugin) InitGenesisStream(
+	ctx sdk.Context, _ *types.GenesisStreamHeader, reader *types.GenesisStreamReader,
+) error {
+	p.Reset(ctx)
+
+	for {
+		record, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
 		}
 
-		if account.Code != nil {
-			p.SetCode(address, account.Code)
+		if record.Account != nil {
+			err = p.initGenesisAccount(record.Address, record.Account)
 		} else {
-			// Initialize the code hash to be empty by default.
-			p.cms.GetKVStore(p.storeKey).Set(CodeHashKeyFor(address), emptyCodeHashBytes)
+			p.SetStorage(record.Address, record.Storage)
+		}
+		if err != nil {
+			return err
 		}
+		p.Finalize()
+	}
+}
 
-		if account.Storage != nil {
-			p.SetStorage(address, account.Storage)
+// initGenesisAccount sets the nonce, balance, code and storage of the given genesis account.
+func (p *plugin) initGenesisAccount(address common.Address, account *core.GenesisAccount) error {
+	// Initialize the account on the auth keeper.
+	// NOTE: The auth module's init genesis runs before the evm module's init genesis.
+	if p.Exist(address) {
+		// If the account exists on the auth keeper, ensure the nonce is consistent.
+		if p.GetNonce(address) != account.Nonce {
+			return fmt.Errorf(
+				"account nonce mismatch for (%s) between auth (%d) and evm (%d) genesis state",
+				address.Hex(), p.GetNonce(address), account.Nonce,
+			)
 		}
+	} else {
+		p.CreateAccount(address)
+		p.SetNonce(address, account.Nonce)
 	}
 
-	p.Finalize()
+	// Initialize the account data on the state plugin.
+	if account.Balance != nil {
+		p.SetBalance(address, account.Balance)
+	}
+
+	if account.Code != nil {
+		p.SetCode(address, account.Code)
+	} else {
+		// Initialize the code hash to be empty by default.
+		p.cms.GetKVStore(p.storeKey).Set(CodeHashKeyFor(address), emptyCodeHashBytes)
+	}
+
+	if account.Storage != nil {
+		p.SetStorage(address, account.Storage)
+	}
 	return nil
 }
 
 // Export genesis modifies a pointer to a genesis state object and populates it.
 func (p *plugin) ExportGenesis(ctx sdk.Context, ethGen *core.Genesis) {
-	p.Reset(ctx)
 	ethGen.Alloc = make(core.GenesisAlloc)
+	if err := p.IterateGenesisAlloc(ctx, func(record *types.GenesisAccountRecord) error {
+		if record.Account != nil {
+			ethGen.Alloc[record.Address] = *record.Account
+			return nil
+		}
+		for key, value := range record.Storage {
+			ethGen.Alloc[record.Address].Storage[key] = value
+		}
+		return nil
+	}); err != nil {
+		panic(err)
+	}
+}
 
-	// Iterate Balances and set the genesis accounts.
-	p.IterateBalances(func(address common.Address, balance *big.Int) bool {
-		account, ok := ethGen.Alloc[address]
-		if !ok {
-			account = core.GenesisAccount{}
+// IterateGenesisAlloc calls fn with the alloc records of every account with a balance, code hash
+// or storage in the state, in address order. The balance, code hash and storage prefixes of the
+// store are walked once each, side by side, as each of them is ordered by address.
+func (p *plugin) IterateGenesisAlloc(
+	ctx sdk.Context, fn func(*types.GenesisAccountRecord) error,
+) (err error) {
+	p.Reset(ctx)
+	store := p.cms.GetKVStore(p.storeKey)
+
+	balances := storetypes.KVStorePrefixIterator(store, []byte{types.BalanceKeyPrefix})
+	codeHashes := storetypes.KVStorePrefixIterator(store, []byte{types.CodeHashKeyPrefix})
+	storage := storetypes.KVStorePrefixIterator(store, []byte{types.StorageKeyPrefix})
+	defer func() {
+		err = errors.Join(err, balances.Close(), codeHashes.Close(), storage.Close())
+	}()
+
+	for balances.Valid() || codeHashes.Valid() || storage.Valid() {
+		// The next account is the lowest address any of the iterators is at.
+		var address *common.Address
+		next := func(candidate common.Address) {
+			if address == nil || candidate.Cmp(*address) < 0 {
+				address = &candidate
+			}
 		}
-		account.Code = p.GetCode(address)
-		if account.Code != nil {
-			account.Storage = make(map[common.Hash]common.Hash)
+		if balances.Valid() {
+			next(AddressFromBalanceKey(balances.Key()))
 		}
-		account.Balance = p.GetBalance(address)
-		account.Nonce = p.GetNonce(address)
-		ethGen.Alloc[address] = account
-		return false
-	})
-
-	// Iterate Storage and set the genesis accounts.
-	p.IterateState(func(address common.Address, key common.Hash, value common.Hash) bool {
-		account, ok := ethGen.Alloc[address]
-		if !ok {
-			account = core.GenesisAccount{}
+		if codeHashes.Valid() {
+			next(AddressFromCodeHashKey(codeHashes.Key()))
 		}
-		if account.Storage == nil {
-			account.Storage = make(map[common.Hash]common.Hash)
+		if storage.Valid() {
+			next(AddressFromSlotKey(storage.Key()))
 		}
-		account.Storage[key] = value
-
-		account.Code = p.GetCode(address)
-		account.Balance = p.GetBalance(address)
-		account.Nonce = p.GetNonce(address)
-		ethGen.Alloc[address] = account
 
-		return false
-	})
+		account := &core.GenesisAccount{Balance: new(big.Int), Nonce: p.GetNonce(*address)}
+		if balances.Valid() && AddressFromBalanceKey(balances.Key()) == *address {
+			account.Balance.SetBytes(balances.Value())
+			balances.Next()
+		}
+		if codeHashes.Valid() && AddressFromCodeHashKey(codeHashes.Key()) == *address {
+			if codeHash := common.BytesToHash(codeHashes.Value()); codeHash != emptyCodeHash {
+				account.Code = store.Get(CodeKeyFor(codeHash))
+			}
+			codeHashes.Next()
+		}
 
-	// Iterate Code and set the genesis accounts.
-	p.IterateCode(func(address common.Address, codeHash common.Hash) bool {
-		account, ok := ethGen.Alloc[address]
-		if !ok {
-			account = core.GenesisAccount{}
+		// The storage is split into chunks, the first of which is held by the account.
+		record := &types.GenesisAccountRecord{Address: *address, Account: account}
+		slots := make(map[common.Hash]common.Hash)
+		for ; storage.Valid() && AddressFromSlotKey(storage.Key()) == *address; storage.Next() {
+			slots[SlotFromSlotKey(storage.Key())] = common.BytesToHash(storage.Value())
+			if len(slots) < types.GenesisStorageChunkSize {
+				continue
+			}
+			if err = fn(withStorage(record, slots)); err != nil {
+				return err
+			}
+			record = &types.GenesisAccountRecord{Address: *address}
+			slots = make(map[common.Hash]common.Hash)
 		}
-		account.Code = p.GetCode(address)
-		account.Nonce = p.GetNonce(address)
-		if account.Balance == nil {
-			account.Balance = big.NewInt(0)
+		if record.Account != nil || len(slots) > 0 {
+			if err = fn(withStorage(record, slots)); err != nil {
+				return err
+			}
 		}
-		ethGen.Alloc[address] = account
+	}
+	return p.dbErr
+}
 
-		return false
-	})
+// withStorage sets the given storage slots on the record, or on its account if it has one.
+func withStorage(
+	record *types.GenesisAccountRecord, slots map[common.Hash]common.Hash,
+) *types.GenesisAccountRecord {
+	switch {
+	case len(slots) == 0:
+	case record.Account != nil:
+		record.Account.Storage = slots
+	default:
+		record.Storage = slots
+	}
+	return record
 }
@@ -24,18 +24,21 @@
 package state_test
 
 import (
+	"bytes"
 	"math/big"
 
 	"cosmossdk.io/log"
 
 	testutil "github.com/berachain/polaris/cosmos/testutil"
 	"github.com/berachain/polaris/cosmos/x/evm/plugins/state"
+	"github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -101,4 +104,88 @@ var _ = Describe("Genesis", func() {
 		sp.ExportGenesis(ctx, &exportedGenesis)
 		Expect(exportedGenesis.Alloc).To(Equal(genesis.Alloc))
 	})
+
+	It("should stream the alloc in address order and import it back", func() {
+		genesis := new(core.Genesis)
+		genesis.Alloc = make(core.GenesisAlloc)
+		storage := make(map[common.Hash]common.Hash)
+		for i := 0; i <= types.GenesisStorageChunkSize; i++ {
+			storage[common.BigToHash(big.NewInt(int64(i)))] = common.Hash{1}
+		}
+		genesis.Alloc[alice] = core.GenesisAccount{
+			Balance: big.NewInt(5e18), Storage: storage, Code: code, Nonce: 1,
+		}
+		genesis.Alloc[bob] = core.GenesisAccount{Balance: big.NewInt(2e18), Nonce: 2}
+		genesis.Config = params.TestChainConfig
+		Expect(sp.InitGenesis(ctx, genesis)).To(Succeed())
+
+		// Alice's storage does not fit in a single record. The stream has the state root of the
+		// genesis block of the same alloc held in memory.
+		var buf bytes.Buffer
+		writer := types.NewGenesisStreamWriter(&buf)
+		Expect(writer.WriteHeader(core.DefaultGenesis, genesis.ToBlock().Root())).To(Succeed())
+		var records []*types.GenesisAccountRecord
+		Expect(sp.IterateGenesisAlloc(ctx, func(record *types.GenesisAccountRecord) error {
+			records = append(records, record)
+			return writer.WriteRecord(record)
+		})).To(Succeed())
+		Expect(writer.Flush()).To(Succeed())
+		Expect(records).To(HaveLen(3))
+		Expect(records[0].Address).To(Equal(bob))
+		Expect(records[1].Address).To(Equal(alice))
+		Expect(records[1].Account.Storage).To(HaveLen(types.GenesisStorageChunkSize))
+		Expect(records[2].Account).To(BeNil())
+		Expect(records[2].Storage).To(HaveLen(1))
+
+		// Import the stream into a fresh state.
+		newCtx, newAK, _, _ := testutil.SetupMinimalKeepers(log.NewTestLogger(GinkgoT()))
+		newSP := state.NewPlugin(newAK, testutil.EvmKey, nil, &mockPLF{})
+		reader := types.NewGenesisStreamReader(&buf)
+		header, err := reader.ReadHeader()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(newSP.InitGenesisStream(newCtx, header, reader)).To(Succeed())
+		Expect(reader.Close()).To(Succeed())
+
+		var exportedGenesis core.Genesis
+		newSP.ExportGenesis(newCtx, &exportedGenesis)
+		Expect(exportedGenesis.Alloc).To(Equal(genesis.Alloc))
+	})
+
+	It("should reject a stream out of address order", func() {
+		var buf bytes.Buffer
+		writer := types.NewGenesisStreamWriter(&buf)
+		Expect(writer.WriteHeader(core.DefaultGenesis, common.Hash{})).To(Succeed())
+		for _, address := range []common.Address{alice, bob} {
+			Expect(writer.WriteRecord(&types.GenesisAccountRecord{
+				Address: address, Account: &core.GenesisAccount{Balance: big.NewInt(1), Nonce: 2},
+			})).To(Succeed())
+		}
+		Expect(writer.Flush()).To(Succeed())
+
+		reader := types.NewGenesisStreamReader(&buf)
+		header, err := reader.ReadHeader()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sp.InitGenesisStream(ctx, header, reader)).To(
+			MatchError(types.ErrInvalidGenesisStream),
+		)
+		Expect(reader.Close()).To(Succeed())
+	})
+
+	It("should reject a stream with another state root", func() {
+		var buf bytes.Buffer
+		writer := types.NewGenesisStreamWriter(&buf)
+		Expect(writer.WriteHeader(core.DefaultGenesis, common.Hash{1})).To(Succeed())
+		Expect(writer.WriteRecord(&types.GenesisAccountRecord{
+			Address: alice, Account: &core.GenesisAccount{Balance: big.NewInt(1)},
+		})).To(Succeed())
+		Expect(writer.Flush()).To(Succeed())
+
+		reader := types.NewGenesisStreamReader(&buf)
+		header, err := reader.ReadHeader()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sp.InitGenesisStream(ctx, header, reader)).To(
+			MatchError(ContainSubstring("alloc has state root")),
+		)
+		Expect(reader.Close()).To(Succeed())
+	})
 })
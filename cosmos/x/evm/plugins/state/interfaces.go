@@ -46,6 +46,9 @@ type ControllableEventManager interface {
 	BeginPrecompileExecution(events.LogsDB)
 	// EndPrecompileExecution ends a precompile execution by resetting the logs DB to nil.
 	EndPrecompileExecution()
+	// PrecompileEvents returns all the Cosmos events emitted during precompile execution, keyed
+	// by the index of the transaction that emitted them.
+	PrecompileEvents() map[int]sdk.Events
 
 	// IsReadOnly returns true if the EventManager is read-only.
 	IsReadOnly() bool
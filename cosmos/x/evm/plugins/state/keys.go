@@ -86,6 +86,29 @@ func CodeKeyFor(codeHash common.Hash) []byte {
 	return bz
 }
 
+// CodeRefCountKeyFor defines the full key under which the number of accounts with the given code
+// hash is stored.
+func CodeRefCountKeyFor(codeHash common.Hash) []byte {
+	bz := make([]byte, 1+common.HashLength)
+	copy(bz, []byte{types.CodeRefCountKeyPrefix})
+	copy(bz[1:], codeHash[:])
+	return bz
+}
+
+// CodeSizeKeyFor defines the full key under which the size of the code with the given code hash
+// is stored.
+func CodeSizeKeyFor(codeHash common.Hash) []byte {
+	bz := make([]byte, 1+common.HashLength)
+	copy(bz, []byte{types.CodeSizeKeyPrefix})
+	copy(bz[1:], codeHash[:])
+	return bz
+}
+
+// CodeHashFromCodeKey returns the code hash from a code, code ref count or code size key.
+func CodeHashFromCodeKey(key []byte) common.Hash {
+	return common.BytesToHash(key[1:])
+}
+
 // AddressFromCodeHashKey returns the address from a code hash key.
 func AddressFromCodeHashKey(key []byte) common.Address {
 	return common.BytesToAddress(key[1:])
@@ -102,3 +102,23 @@ var _ = Describe("CodeKeyFor", func() {
 		Expect(key[1:]).To(Equal(address.Bytes()))
 	})
 })
+
+var _ = Describe("CodeRefCountKeyFor", func() {
+	It("returns a code ref count key for a given code hash", func() {
+		codeHash := common.HexToHash("0x1234567890abcdef1234567890abcdef12345678")
+		key := CodeRefCountKeyFor(codeHash)
+		Expect(key).To(HaveLen(1 + common.HashLength))
+		Expect(key[0]).To(Equal(types.CodeRefCountKeyPrefix))
+		Expect(CodeHashFromCodeKey(key)).To(Equal(codeHash))
+	})
+})
+
+var _ = Describe("CodeSizeKeyFor", func() {
+	It("returns a code size key for a given code hash", func() {
+		codeHash := common.HexToHash("0x1234567890abcdef1234567890abcdef12345678")
+		key := CodeSizeKeyFor(codeHash)
+		Expect(key).To(HaveLen(1 + common.HashLength))
+		Expect(key[0]).To(Equal(types.CodeSizeKeyPrefix))
+		Expect(CodeHashFromCodeKey(key)).To(Equal(codeHash))
+	})
+})
@@ -39,6 +39,7 @@ import (
 	ethstate "github.com/berachain/polaris/eth/core/state"
 	"github.com/berachain/polaris/lib/snapshot"
 	libtypes "github.com/berachain/polaris/lib/types"
+	"github.com/berachain/polaris/lib/utils"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -58,15 +59,22 @@ var (
 // Plugin is the interface that must be implemented by the plugin.
 type Plugin interface {
 	plugins.HasGenesis
+	plugins.HasGenesisStream
 	core.StatePlugin
 	// IterateBalances iterates over the balances of all accounts and calls the callback function.
 	IterateBalances(fn func(common.Address, *big.Int) bool)
 	// IterateState iterates over the state of all accounts and calls the callback function.
 	IterateState(fn func(addr common.Address, key common.Hash, value common.Hash) bool)
+	// IterateGenesisAlloc calls the callback function with the alloc records of every account,
+	// in address order, walking the store once.
+	IterateGenesisAlloc(ctx sdk.Context, fn func(*types.GenesisAccountRecord) error) error
 	// SetGasConfig sets the gas config for the plugin.
 	SetGasConfig(storetypes.GasConfig, storetypes.GasConfig)
 	// SetPrecompileLogFactory sets the precompile log factory for the plugin.
 	SetPrecompileLogFactory(events.PrecompileLogFactory)
+	// PrecompileEvents returns all the Cosmos events emitted during precompile execution since
+	// the last reset, keyed by the index of the transaction that emitted them.
+	PrecompileEvents() map[int]sdk.Events
 }
 
 // The StatePlugin is a very fun and interesting part of the EVM implementation. But if you want to
@@ -184,6 +192,11 @@ func (p *plugin) RegistryKey() string {
 	return pluginRegistryKey
 }
 
+// PrecompileEvents implements `Plugin`.
+func (p *plugin) PrecompileEvents() map[int]sdk.Events {
+	return utils.MustGetAs[ControllableEventManager](p.ctx.EventManager()).PrecompileEvents()
+}
+
 // GetContext implements `core.StatePlugin`.
 func (p *plugin) GetContext() context.Context {
 	return p.ctx
@@ -211,8 +224,10 @@ func (p *plugin) CreateAccount(addr common.Address) {
 		p.ak.SetAccount(p.ctx, p.ak.NewAccountWithAddress(p.ctx, addr[:]))
 	}
 
-	// initialize the code hash to empty
-	p.cms.GetKVStore(p.storeKey).Set(CodeHashKeyFor(addr), emptyCodeHashBytes)
+	// initialize the code hash to empty, releasing any code the account had
+	ethStore := p.cms.GetKVStore(p.storeKey)
+	releaseCode(ethStore, common.BytesToHash(ethStore.Get(CodeHashKeyFor(addr))))
+	ethStore.Set(CodeHashKeyFor(addr), emptyCodeHashBytes)
 }
 
 // GetNonce implements the `StatePlugin` interface by returning the nonce
@@ -280,8 +295,13 @@ func (p *plugin) DeleteAccounts(accounts []common.Address) {
 				return true
 			})
 
-		// clear the codehash from this account
-		p.cms.GetKVStore(p.storeKey).Delete(CodeHashKeyFor(account))
+		// clear the codehash from this account, and its code if no other account uses it
+		ethStore := p.cms.GetKVStore(p.storeKey)
+		releaseCode(ethStore, common.BytesToHash(ethStore.Get(CodeHashKeyFor(account))))
+		ethStore.Delete(CodeHashKeyFor(account))
+
+		// burn any balance sent to the account after it self destructed
+		p.cms.GetKVStore(p.storeKey).Delete(BalanceKeyFor(account))
 
 		// remove auth account
 		p.ak.RemoveAccount(p.ctx, acct)
@@ -353,18 +373,32 @@ func (p *plugin) GetCode(addr common.Address) []byte {
 	return p.cms.GetKVStore(p.storeKey).Get(CodeKeyFor(codeHash))
 }
 
+// GetCodeSize implements the `StatePlugin` interface by returning the size of the code of
+// account from the code size index, without loading the code.
+func (p *plugin) GetCodeSize(addr common.Address) int {
+	codeHash := p.GetCodeHash(addr)
+	if (codeHash == common.Hash{}) || codeHash == emptyCodeHash {
+		return 0
+	}
+	return codeSize(p.cms.GetKVStore(p.storeKey), codeHash)
+}
+
 // SetCode implements the `StatePlugin` interface by setting the code hash and
-// code for the given account.
+// code for the given account. The code is shared by every account with the same code hash, so
+// the previous code of the account is only deleted once no other account references it.
 func (p *plugin) SetCode(addr common.Address, code []byte) {
 	codeHash := crypto.Keccak256Hash(code)
 	ethStore := p.cms.GetKVStore(p.storeKey)
+	prevCodeHash := common.BytesToHash(ethStore.Get(CodeHashKeyFor(addr)))
+	if prevCodeHash == codeHash {
+		return
+	}
 	ethStore.Set(CodeHashKeyFor(addr), codeHash[:])
 
-	// store or delete code
-	if len(code) == 0 {
-		ethStore.Delete(CodeKeyFor(codeHash))
-	} else {
-		ethStore.Set(CodeKeyFor(codeHash), code)
+	// release the previous code, and retain the new code unless it is empty
+	releaseCode(ethStore, prevCodeHash)
+	if len(code) != 0 {
+		retainCode(ethStore, codeHash, code)
 	}
 }
 
@@ -198,6 +198,28 @@ var _ = Describe("State Plugin", func() {
 					Expect(sp.GetCodeHash(alice)).To(Equal(emptyCodeHash))
 				})
 			})
+
+			When("accounts share code", func() {
+				BeforeEach(func() {
+					sp.CreateAccount(bob)
+					sp.SetCode(alice, []byte("code"))
+					sp.SetCode(bob, []byte("code"))
+				})
+				It("should index the code size", func() {
+					Expect(sp.GetCodeSize(alice)).To(Equal(len("code")))
+					Expect(sp.GetCodeSize(bob)).To(Equal(len("code")))
+				})
+				It("should keep the code while it is referenced", func() {
+					sp.SetCode(alice, nil)
+					Expect(sp.GetCode(bob)).To(Equal([]byte("code")))
+					sp.DeleteAccounts([]common.Address{bob})
+					sp.Finalize()
+					store := ctx.MultiStore().GetKVStore(testutil.EvmKey)
+					Expect(store.Get(state.CodeKeyFor(crypto.Keccak256Hash([]byte("code"))))).
+						To(BeNil())
+					Expect(state.CheckCodeIndex(store)).To(Succeed())
+				})
+			})
 		})
 	})
 
@@ -316,11 +338,13 @@ var _ = Describe("State Plugin", func() {
 				sp.CreateAccount(alice)
 				sp.SetCode(alice, aliceCode)
 				sp.SetState(alice, common.BytesToHash([]byte{1}), common.BytesToHash([]byte{2}))
+				sp.AddBalance(alice, big.NewInt(1))
 			})
 
 			It("should remove storage/codehash/acct", func() {
 				sp.DeleteAccounts([]common.Address{alice, alice})
 				Expect(ak.HasAccount(ctx, alice[:])).To(BeFalse())
+				Expect(sp.GetBalance(alice)).To(Equal(new(big.Int)))
 				Expect(sp.GetCode(alice)).To(BeNil())
 				Expect(sp.GetState(alice, common.BytesToHash([]byte{1}))).To(Equal(common.Hash{}))
 			})
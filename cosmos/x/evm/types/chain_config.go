@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var (
+	// ErrChainConfigConflict is returned when the chain config of the node conflicts with the
+	// chain config stored on-chain.
+	ErrChainConfigConflict = errors.New("local chain config conflicts with on-chain chain config")
+	// ErrInvalidAuthority is returned when a message is not signed by the module's authority.
+	ErrInvalidAuthority = errors.New("invalid authority")
+	// ErrInvalidHardfork is returned when a hardfork cannot be scheduled.
+	ErrInvalidHardfork = errors.New("invalid hardfork")
+)
+
+// ChainConfig is the chain config stored on-chain, i.e. the EVM chain config along with the
+// activation of the Polaris specific consensus rules.
+type ChainConfig struct {
+	*params.ChainConfig
+	// PrevRandaoTime is the time from which the random value of execution payloads is derived
+	// from consensus data, see PrevRandao, rather than being zero (nil = never, 0 = genesis).
+	PrevRandaoTime *uint64 `json:"prevRandaoTime,omitempty"`
+}
+
+// IsPrevRandao returns whether the random value of the execution payload with the given time is
+// derived from consensus data.
+func (c *ChainConfig) IsPrevRandao(time uint64) bool {
+	return c.PrevRandaoTime != nil && *c.PrevRandaoTime <= time
+}
+
+// MarshalChainConfig returns the JSON encoding of the given chain config.
+func MarshalChainConfig(cfg *ChainConfig) ([]byte, error) {
+	return json.Marshal(cfg)
+}
+
+// UnmarshalChainConfig returns the chain config with the given JSON encoding.
+func UnmarshalChainConfig(bz []byte) (*ChainConfig, error) {
+	cfg := &ChainConfig{ChainConfig: new(params.ChainConfig)}
+	if err := json.Unmarshal(bz, cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// ValidateChainConfigUpdate returns an error if the current chain config cannot be replaced by
+// the next one at the given block number and time, i.e. if the chain ID changes, the forks are
+// not in order or the rules of the blocks that were already produced change.
+func ValidateChainConfigUpdate(current, next *ChainConfig, number *big.Int, time uint64) error {
+	if next.ChainID == nil || current.ChainID.Cmp(next.ChainID) != 0 {
+		return fmt.Errorf("chain ID cannot be changed from %v to %v", current.ChainID, next.ChainID)
+	}
+	if err := next.CheckConfigForkOrder(); err != nil {
+		return err
+	}
+	if err := current.CheckCompatible(next.ChainConfig, number.Uint64(), time); err != nil {
+		return err
+	}
+	if current.IsPrevRandao(time) != next.IsPrevRandao(time) {
+		return fmt.Errorf("%w: prevrandao activation cannot be changed from %s to %s at time %d",
+			ErrInvalidHardfork, formatForkValue(reflect.ValueOf(current.PrevRandaoTime)),
+			formatForkValue(reflect.ValueOf(next.PrevRandaoTime)), time)
+	}
+	return nil
+}
+
+// ScheduleHardfork returns a copy of the given chain config with the time based hardfork of the
+// given name activating at the given time. Besides the hardforks of the EVM, the "prevrandao"
+// hardfork activates the derivation of the random value of execution payloads.
+func ScheduleHardfork(cfg *ChainConfig, name string, time uint64) (*ChainConfig, error) {
+	chainConfig := *cfg.ChainConfig
+	next := ChainConfig{ChainConfig: &chainConfig, PrevRandaoTime: cfg.PrevRandaoTime}
+	switch name {
+	case "shanghai":
+		next.ShanghaiTime = &time
+	case "cancun":
+		next.CancunTime = &time
+	case "prague":
+		next.PragueTime = &time
+	case "verkle":
+		next.VerkleTime = &time
+	case "prevrandao":
+		next.PrevRandaoTime = &time
+	default:
+		return nil, fmt.Errorf("%w: unknown time based hardfork %q", ErrInvalidHardfork, name)
+	}
+	return &next, nil
+}
+
+// CheckChainConfigConflict returns an error if any value set in the local chain config differs
+// from the on-chain chain config. Values that are not set locally, e.g. hardforks scheduled by
+// governance, are not conflicts.
+func CheckChainConfigConflict(local, onChain *params.ChainConfig) error {
+	var (
+		localValue   = reflect.ValueOf(local).Elem()
+		onChainValue = reflect.ValueOf(onChain).Elem()
+	)
+	for i := 0; i < localValue.NumField(); i++ {
+		field := localValue.Type().Field(i)
+		l, o := localValue.Field(i), onChainValue.Field(i)
+		if l.Kind() != reflect.Pointer || l.IsNil() {
+			continue
+		}
+
+		var equal bool
+		switch lv := l.Interface().(type) {
+		case *big.Int:
+			ov, _ := o.Interface().(*big.Int)
+			equal = ov != nil && lv.Cmp(ov) == 0
+		case *uint64:
+			ov, _ := o.Interface().(*uint64)
+			equal = ov != nil && *lv == *ov
+		default:
+			continue
+		}
+		if !equal {
+			return fmt.Errorf("%w: %s is %s locally but %s on-chain",
+				ErrChainConfigConflict, field.Name, formatForkValue(l), formatForkValue(o))
+		}
+	}
+	return nil
+}
+
+// formatForkValue returns the human readable form of a fork block or time of a chain config.
+func formatForkValue(v reflect.Value) string {
+	if v.IsNil() {
+		return "unset"
+	}
+	switch value := v.Interface().(type) {
+	case *big.Int:
+		return value.String()
+	case *uint64:
+		return strconv.FormatUint(*value, 10)
+	default:
+		return fmt.Sprint(value)
+	}
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package types_test
+
+import (
+	"math/big"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+	"github.com/berachain/polaris/eth/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ChainConfig", func() {
+	var cfg *types.ChainConfig
+
+	BeforeEach(func() {
+		local := *params.DefaultChainConfig
+		cfg = &types.ChainConfig{ChainConfig: &local}
+	})
+
+	It("should encode the prevrandao activation along with the EVM chain config", func() {
+		activation := uint64(100)
+		cfg.PrevRandaoTime = &activation
+		bz, err := types.MarshalChainConfig(cfg)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(bz)).To(ContainSubstring(`"prevRandaoTime":100`))
+
+		decoded, err := types.UnmarshalChainConfig(bz)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decoded).To(Equal(cfg))
+		Expect(decoded.IsPrevRandao(99)).To(BeFalse())
+		Expect(decoded.IsPrevRandao(100)).To(BeTrue())
+	})
+
+	It("should schedule the prevrandao activation", func() {
+		next, err := types.ScheduleHardfork(cfg, "prevrandao", 100)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*next.PrevRandaoTime).To(Equal(uint64(100)))
+		Expect(cfg.PrevRandaoTime).To(BeNil())
+		Expect(types.ValidateChainConfigUpdate(cfg, next, big.NewInt(1), 50)).To(Succeed())
+
+		// The activation cannot change once the random value is derived.
+		later, err := types.ScheduleHardfork(next, "prevrandao", 200)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(types.ValidateChainConfigUpdate(next, later, big.NewInt(1), 50)).To(Succeed())
+		Expect(types.ValidateChainConfigUpdate(next, later, big.NewInt(1), 150)).
+			To(MatchError(types.ErrInvalidHardfork))
+	})
+})
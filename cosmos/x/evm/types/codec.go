@@ -35,6 +35,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&WrappedEthereumTransaction{},
 		&WrappedPayloadEnvelope{},
+		&MsgUpdateParams{},
+		&MsgScheduleHardfork{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_MsgService_serviceDesc)
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package types
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ValidatorStore maps the consensus address of a validator to the validator, and is used to
+// pay the priority fees of a block to the validator that proposed it.
+type ValidatorStore interface {
+	ValidatorByConsAddr(context.Context, sdk.ConsAddress) (stakingtypes.ValidatorI, error)
+}
+
+// ProposerOperator returns the EVM address of the operator of the validator proposing the block
+// of the given context, which is the fee recipient of the execution payload of the block. Every
+// validator derives it from the same state in both PrepareProposal and ProcessProposal.
+func ProposerOperator(ctx sdk.Context, validators ValidatorStore) (common.Address, error) {
+	proposer := sdk.ConsAddress(ctx.BlockHeader().ProposerAddress)
+	val, err := validators.ValidatorByConsAddr(ctx, proposer)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to get block proposer %s: %w", proposer, err)
+	} else if val == nil {
+		return common.Address{}, fmt.Errorf("block proposer %s not found", proposer)
+	}
+	operator, err := sdk.ValAddressFromBech32(val.GetOperator())
+	if err != nil {
+		return common.Address{}, fmt.Errorf(
+			"invalid block proposer operator %s: %w", val.GetOperator(), err,
+		)
+	}
+	return common.BytesToAddress(operator), nil
+}
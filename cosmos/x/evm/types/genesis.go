@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/berachain/polaris/eth/core"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// ErrInvalidGenesis is wrapped by every problem found while validating an EVM genesis.
+var ErrInvalidGenesis = errors.New("invalid evm genesis")
+
+// maxUint256BitLen is the maximum bit length of a balance or base fee.
+const maxUint256BitLen = 256
+
+// ValidateGenesis validates the given EVM genesis and returns all the problems found, joined into
+// a single error. If authNonces is not nil, the nonce of every alloc account that also exists in
+// the auth module's genesis must match its sequence there, as is required by InitGenesis.
+func ValidateGenesis(ethGen *core.Genesis, authNonces map[common.Address]uint64) error {
+	var errs []error
+	report := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%w: "+format, append([]any{ErrInvalidGenesis}, args...)...))
+	}
+
+	validateGenesisHeader(ethGen, report)
+
+	// Sort the addresses so that the problems are reported in a consistent order.
+	addresses := make([]common.Address, 0, len(ethGen.Alloc))
+	for address := range ethGen.Alloc {
+		addresses = append(addresses, address)
+	}
+	slices.SortFunc(addresses, func(a, b common.Address) int { return a.Cmp(b) })
+
+	for _, address := range addresses {
+		account := ethGen.Alloc[address]
+		validateGenesisAccount(address, &account, authNonces, report)
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateGenesisStream is ValidateGenesis for a streamed genesis read from r, including that the
+// alloc derives the state root of the header. Problems with the format of the stream end the
+// validation, as the records that follow cannot be trusted.
+func ValidateGenesisStream(r io.Reader, authNonces map[common.Address]uint64) error {
+	var errs []error
+	report := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%w: "+format, append([]any{ErrInvalidGenesis}, args...)...))
+	}
+
+	reader := NewGenesisStreamReader(r)
+	header, err := reader.ReadHeader()
+	if err != nil {
+		return err
+	}
+	validateGenesisHeader(header.Genesis, report)
+
+	// hasCode is whether the account of the last record read has code.
+	var hasCode bool
+	for {
+		record, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			errs = append(errs, err)
+			break
+		}
+
+		if record.Account != nil {
+			hasCode = len(record.Account.Code) > 0
+			validateGenesisAccount(record.Address, record.Account, authNonces, report)
+		} else if !hasCode {
+			report("account (%s) has storage but no code", record.Address.Hex())
+		}
+	}
+
+	return errors.Join(append(errs, reader.Close())...)
+}
+
+// validateGenesisHeader reports the problems with the chain config and the genesis block fields
+// of the given genesis.
+func validateGenesisHeader(ethGen *core.Genesis, report func(format string, args ...any)) {
+	if cfg := ethGen.Config; cfg != nil {
+		if cfg.ChainID == nil {
+			report("chain config is missing the chain ID")
+		}
+		if err := cfg.CheckConfigForkOrder(); err != nil {
+			report("chain config: %v", err)
+		}
+	}
+
+	if ethGen.GasLimit < params.MinGasLimit || ethGen.GasLimit > params.MaxGasLimit {
+		report(
+			"gas limit %d is out of bounds [%d, %d]",
+			ethGen.GasLimit, params.MinGasLimit, params.MaxGasLimit,
+		)
+	}
+	if ethGen.BaseFee != nil && !isUint256(ethGen.BaseFee) {
+		report("base fee %v is not a valid uint256", ethGen.BaseFee)
+	}
+}
+
+// validateGenesisAccount reports the problems with the given alloc account.
+func validateGenesisAccount(
+	address common.Address,
+	account *core.GenesisAccount,
+	authNonces map[common.Address]uint64,
+	report func(format string, args ...any),
+) {
+	if account.Balance != nil && !isUint256(account.Balance) {
+		report("account (%s) balance %v is not a valid uint256", address.Hex(), account.Balance)
+	}
+	if len(account.Code) == 0 && len(account.Storage) > 0 {
+		report("account (%s) has storage but no code", address.Hex())
+	}
+	if len(account.Code) > params.MaxCodeSize {
+		report(
+			"account (%s) code size %d exceeds the maximum of %d",
+			address.Hex(), len(account.Code), params.MaxCodeSize,
+		)
+	}
+	// Code starting with the 0xEF byte can never be deployed (EIP-3541), so its code hash
+	// would not match the hash of any contract created on this chain.
+	if bytes.HasPrefix(account.Code, []byte{0xEF}) {
+		report("account (%s) code starts with the reserved 0xEF byte", address.Hex())
+	}
+	if authNonce, ok := authNonces[address]; ok && authNonce != account.Nonce {
+		report(
+			"account nonce mismatch for (%s) between auth (%d) and evm (%d) genesis state",
+			address.Hex(), authNonce, account.Nonce,
+		)
+	}
+}
+
+// isUint256 returns whether the given value is non-negative and fits in 256 bits.
+func isUint256(value *big.Int) bool {
+	return value.Sign() >= 0 && value.BitLen() <= maxUint256BitLen
+}
@@ -0,0 +1,371 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package types
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/berachain/polaris/eth/core"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+)
+
+// GenesisStorageChunkSize is the maximum number of storage slots held by a single record of a
+// streamed genesis. The storage of larger accounts is split over consecutive records.
+const GenesisStorageChunkSize = 1024
+
+// FlagGenesisStream is the flag of the export command with the file that the evm genesis state
+// is streamed to, instead of being embedded in the exported genesis.
+const FlagGenesisStream = "evm-genesis-stream"
+
+// A streamed genesis is a sequence of JSON values, one per line: a GenesisStreamHeader followed
+// by the GenesisAccountRecords of the alloc, in strictly increasing address order. It is used
+// instead of a single core.Genesis JSON blob for states too large to be held in memory.
+type (
+	// GenesisStreamHeader is the first line of a streamed genesis.
+	GenesisStreamHeader struct {
+		// Genesis is the genesis without its alloc.
+		Genesis *core.Genesis `json:"genesis"`
+		// StateRoot is the state root of the alloc, i.e. of the genesis block. The reader checks
+		// it against the records once they are all read.
+		StateRoot common.Hash `json:"stateRoot"`
+	}
+
+	// GenesisAccountRecord is a line of the alloc of a streamed genesis. The first record of an
+	// account holds the account, with up to GenesisStorageChunkSize storage slots, and the
+	// following records of the same account only hold the rest of its storage.
+	GenesisAccountRecord struct {
+		Address common.Address              `json:"address"`
+		Account *core.GenesisAccount        `json:"account,omitempty"`
+		Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+	}
+
+	// GenesisStreamRef is the evm module's genesis state when the genesis is streamed from a
+	// separate file, i.e. `{"stream": "<path>", "hash": "<sha256>"}`. A relative path is relative
+	// to the config directory of the node home, which holds genesis.json.
+	GenesisStreamRef struct {
+		// Stream is the path of the streamed genesis.
+		Stream string `json:"stream"`
+		// Hash is the hex encoded SHA-256 hash of the content of the streamed genesis.
+		Hash string `json:"hash"`
+	}
+)
+
+// ErrInvalidGenesisStream is returned when a streamed genesis is malformed.
+var ErrInvalidGenesisStream = errors.New("invalid genesis stream")
+
+// GenesisStreamRefFrom returns the reference to the streamed genesis of the given evm module
+// genesis state, if it is one.
+func GenesisStreamRefFrom(bz json.RawMessage) (*GenesisStreamRef, bool) {
+	ref := new(GenesisStreamRef)
+	if err := json.Unmarshal(bz, ref); err != nil || ref.Stream == "" {
+		return nil, false
+	}
+	return ref, true
+}
+
+// CreateGenesisStream creates the file that a streamed genesis is written to, failing if it
+// already exists rather than overwriting it.
+func CreateGenesisStream(path string) (*os.File, error) {
+	//nolint:gosec // the genesis state is public.
+	return os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+}
+
+// Validate returns an error if the reference has no valid content hash.
+func (ref *GenesisStreamRef) Validate() error {
+	if hash, err := hex.DecodeString(ref.Hash); err != nil || len(hash) != sha256.Size {
+		return fmt.Errorf(
+			"%w: %s must have the hex encoded sha256 hash of its content", ErrInvalidGenesisStream,
+			ref.Stream,
+		)
+	}
+	return nil
+}
+
+// Open opens the referenced streamed genesis, with a relative path being relative to the given
+// config directory, and checks that its content matches the hash of the reference.
+func (ref *GenesisStreamRef) Open(configDir string) (*os.File, error) {
+	if err := ref.Validate(); err != nil {
+		return nil, err
+	}
+	path := ref.Stream
+	if !filepath.IsAbs(path) {
+		if configDir == "" {
+			return nil, fmt.Errorf(
+				"%w: relative path %s without a node home", ErrInvalidGenesisStream, path,
+			)
+		}
+		path = filepath.Join(configDir, path)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	hasher := sha256.New()
+	if _, err = io.Copy(hasher, f); err != nil {
+		return nil, errors.Join(err, f.Close())
+	}
+	if hash := hex.EncodeToString(hasher.Sum(nil)); !strings.EqualFold(hash, ref.Hash) {
+		return nil, errors.Join(fmt.Errorf(
+			"%w: hash of %s is %s, expected %s", ErrInvalidGenesisStream, path, hash, ref.Hash,
+		), f.Close())
+	}
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		return nil, errors.Join(err, f.Close())
+	}
+	return f, nil
+}
+
+// Block returns the genesis block of the streamed genesis, with the state root of the header.
+func (h *GenesisStreamHeader) Block() *ethtypes.Block {
+	return h.Genesis.ToBlockWithRoot(h.StateRoot)
+}
+
+// GenesisStreamWriter writes a streamed genesis.
+type GenesisStreamWriter struct {
+	w      *bufio.Writer
+	enc    *json.Encoder
+	hasher hash.Hash
+}
+
+// NewGenesisStreamWriter returns a GenesisStreamWriter writing to w. Flush must be called once
+// all the records are written.
+func NewGenesisStreamWriter(w io.Writer) *GenesisStreamWriter {
+	hasher := sha256.New()
+	bw := bufio.NewWriter(io.MultiWriter(w, hasher))
+	return &GenesisStreamWriter{w: bw, enc: json.NewEncoder(bw), hasher: hasher}
+}
+
+// WriteHeader writes the header of the streamed genesis. The alloc of the given genesis is
+// ignored.
+func (sw *GenesisStreamWriter) WriteHeader(gen *core.Genesis, stateRoot common.Hash) error {
+	withoutAlloc := *gen
+	withoutAlloc.Alloc = core.GenesisAlloc{}
+	return sw.enc.Encode(&GenesisStreamHeader{Genesis: &withoutAlloc, StateRoot: stateRoot})
+}
+
+// WriteRecord writes the given alloc record.
+func (sw *GenesisStreamWriter) WriteRecord(record *GenesisAccountRecord) error {
+	return sw.enc.Encode(record)
+}
+
+// Flush writes any buffered data to the underlying writer.
+func (sw *GenesisStreamWriter) Flush() error {
+	return sw.w.Flush()
+}
+
+// Hash returns the hex encoded SHA-256 hash of the content flushed so far, as referenced by
+// GenesisStreamRef.
+func (sw *GenesisStreamWriter) Hash() string {
+	return hex.EncodeToString(sw.hasher.Sum(nil))
+}
+
+// GenesisStreamReader reads a streamed genesis, checking that the records are in order and that
+// they derive the state root of the header.
+type GenesisStreamReader struct {
+	dec *json.Decoder
+	// last is the address of the last record read.
+	last *common.Address
+	// stateRoot is the state root of the header.
+	stateRoot common.Hash
+	// hasher derives the state root of the records read.
+	hasher *GenesisRootHasher
+}
+
+// NewGenesisStreamReader returns a GenesisStreamReader reading from r.
+func NewGenesisStreamReader(r io.Reader) *GenesisStreamReader {
+	return &GenesisStreamReader{dec: json.NewDecoder(bufio.NewReader(r))}
+}
+
+// ReadHeader reads the header of the streamed genesis. It must be called before Next.
+func (sr *GenesisStreamReader) ReadHeader() (*GenesisStreamHeader, error) {
+	header := new(GenesisStreamHeader)
+	if err := sr.dec.Decode(header); err != nil {
+		return nil, fmt.Errorf("%w: failed to read header: %w", ErrInvalidGenesisStream, err)
+	}
+	if header.Genesis == nil {
+		return nil, fmt.Errorf("%w: header has no genesis", ErrInvalidGenesisStream)
+	}
+
+	hasher, err := NewGenesisRootHasher()
+	if err != nil {
+		return nil, err
+	}
+	sr.stateRoot, sr.hasher = header.StateRoot, hasher
+	return header, nil
+}
+
+// Close releases the resources used to derive the state root.
+func (sr *GenesisStreamReader) Close() error {
+	if sr.hasher == nil {
+		return nil
+	}
+	return sr.hasher.Close()
+}
+
+// Next returns the next alloc record, or io.EOF once all the records are read and they derive
+// the state root of the header.
+func (sr *GenesisStreamReader) Next() (*GenesisAccountRecord, error) {
+	record := new(GenesisAccountRecord)
+	if err := sr.dec.Decode(record); errors.Is(err, io.EOF) {
+		return nil, sr.checkStateRoot()
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: failed to read record: %w", ErrInvalidGenesisStream, err)
+	}
+
+	switch {
+	case record.Account == nil && (sr.last == nil || *sr.last != record.Address):
+		return nil, fmt.Errorf(
+			"%w: storage record of (%s) does not follow its account",
+			ErrInvalidGenesisStream, record.Address.Hex(),
+		)
+	case record.Account != nil && sr.last != nil && sr.last.Cmp(record.Address) >= 0:
+		return nil, fmt.Errorf(
+			"%w: account (%s) is not in increasing address order",
+			ErrInvalidGenesisStream, record.Address.Hex(),
+		)
+	case record.Account != nil && record.Storage != nil:
+		return nil, fmt.Errorf(
+			"%w: first record of (%s) holds storage outside of its account",
+			ErrInvalidGenesisStream, record.Address.Hex(),
+		)
+	}
+	sr.last = &record.Address
+	if err := sr.hasher.Add(record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// checkStateRoot returns io.EOF if the records read derive the state root of the header.
+func (sr *GenesisStreamReader) checkStateRoot() error {
+	root, err := sr.hasher.Root()
+	if err != nil {
+		return err
+	}
+	if root != sr.stateRoot {
+		return fmt.Errorf(
+			"%w: alloc has state root %s, expected %s", ErrInvalidGenesisStream, root.Hex(),
+			sr.stateRoot.Hex(),
+		)
+	}
+	return io.EOF
+}
+
+// genesisRootFlushInterval is the number of alloc records after which GenesisRootHasher flushes
+// the trie to its database.
+const genesisRootFlushInterval = 1024
+
+// GenesisRootHasher derives the state root of a streamed alloc, as core.Genesis.ToBlock does for
+// an alloc held in memory. The trie is flushed to a temporary database on disk every
+// genesisRootFlushInterval records, so that the alloc is never held in memory.
+type GenesisRootHasher struct {
+	dir     string
+	diskdb  ethdb.Database
+	db      state.Database
+	statedb *state.StateDB
+	// pending is the number of records added since the last flush.
+	pending int
+}
+
+// NewGenesisRootHasher returns a GenesisRootHasher of an empty alloc. Close must be called to
+// remove its temporary database.
+func NewGenesisRootHasher() (*GenesisRootHasher, error) {
+	dir, err := os.MkdirTemp("", "polaris-genesis-root")
+	if err != nil {
+		return nil, err
+	}
+	diskdb, err := rawdb.NewLevelDBDatabase(dir, 0, 0, "", false)
+	if err != nil {
+		return nil, errors.Join(err, os.RemoveAll(dir))
+	}
+	h := &GenesisRootHasher{dir: dir, diskdb: diskdb, db: state.NewDatabase(diskdb)}
+	if h.statedb, err = state.New(ethtypes.EmptyRootHash, h.db, nil); err != nil {
+		return nil, errors.Join(err, h.Close())
+	}
+	return h, nil
+}
+
+// Add adds the given alloc record to the state.
+func (h *GenesisRootHasher) Add(record *GenesisAccountRecord) error {
+	storage := record.Storage
+	if account := record.Account; account != nil {
+		if account.Balance != nil {
+			h.statedb.AddBalance(record.Address, account.Balance)
+		}
+		h.statedb.SetCode(record.Address, account.Code)
+		h.statedb.SetNonce(record.Address, account.Nonce)
+		storage = account.Storage
+	}
+	for key, value := range storage {
+		h.statedb.SetState(record.Address, key, value)
+	}
+
+	if h.pending++; h.pending < genesisRootFlushInterval {
+		return nil
+	}
+	_, err := h.flush()
+	return err
+}
+
+// Root returns the state root of the records added so far.
+func (h *GenesisRootHasher) Root() (common.Hash, error) {
+	return h.flush()
+}
+
+// Close closes and removes the temporary database.
+func (h *GenesisRootHasher) Close() error {
+	return errors.Join(h.diskdb.Close(), os.RemoveAll(h.dir))
+}
+
+// flush commits the state to the database and returns its root.
+func (h *GenesisRootHasher) flush() (common.Hash, error) {
+	root, err := h.statedb.Commit(0, false)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err = h.db.TrieDB().Commit(root, false); err != nil {
+		return common.Hash{}, err
+	}
+	if h.statedb, err = state.New(root, h.db, nil); err != nil {
+		return common.Hash{}, err
+	}
+	h.pending = 0
+	return root, nil
+}
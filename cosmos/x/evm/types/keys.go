@@ -44,4 +44,10 @@ const (
 	GenesisHeaderKey
 	ParamsKey
 	ChainConfigPrefix
+	BlobSidecarsPrefix
+	EarliestBlockKey
+	TxHashKeyToCosmosEventsPrefix
+	CodeRefCountKeyPrefix
+	CodeSizeKeyPrefix
+	TxHashKeyToNumPrefix
 )
@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// ParentBeaconRoot returns the parent beacon block root of the execution payload with the given
+// number and time, built on top of the Cosmos block with the given app hash. Since there is no
+// beacon chain, the app hash, which is known to every validator before the payload is built, is
+// used. Returns nil if Cancun is not active for the payload.
+func ParentBeaconRoot(
+	cfg *params.ChainConfig, number *big.Int, time uint64, appHash []byte,
+) *common.Hash {
+	if !cfg.IsCancun(number, time) {
+		return nil
+	}
+	root := common.BytesToHash(appHash)
+	return &root
+}
+
+// EnvelopeToBlock converts the execution payload of the given envelope to a block, verifying the
+// blobs bundle against the blob transactions in the payload. Also returns the blob sidecars of
+// the blob transactions in the block, in transaction order.
+func EnvelopeToBlock(
+	envelope *engine.ExecutionPayloadEnvelope, beaconRoot *common.Hash,
+) (*ethtypes.Block, []*ethtypes.BlobTxSidecar, error) {
+	if envelope == nil || envelope.ExecutionPayload == nil {
+		return nil, nil, errors.New("execution payload envelope is empty")
+	}
+
+	bundle, err := unwrapBlobsBundle(envelope.BlobsBundle)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The versioned hashes of the bundle must match the blob hashes of the payload.
+	block, err := engine.ExecutableDataToBlock(
+		*envelope.ExecutionPayload, bundle.BlobHashes(), beaconRoot,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Split the bundle into the sidecars of the individual blob transactions.
+	var (
+		sidecars []*ethtypes.BlobTxSidecar
+		offset   int
+	)
+	for _, tx := range block.Transactions() {
+		if tx.Type() != ethtypes.BlobTxType {
+			continue
+		}
+		next := offset + len(tx.BlobHashes())
+		sidecars = append(sidecars, &ethtypes.BlobTxSidecar{
+			Blobs:       bundle.Blobs[offset:next],
+			Commitments: bundle.Commitments[offset:next],
+			Proofs:      bundle.Proofs[offset:next],
+		})
+		offset = next
+	}
+	return block, sidecars, nil
+}
+
+// unwrapBlobsBundle decodes the given blobs bundle into a single sidecar and verifies the
+// proof of every blob against its commitment.
+func unwrapBlobsBundle(bundle *engine.BlobsBundleV1) (*ethtypes.BlobTxSidecar, error) {
+	sidecar := &ethtypes.BlobTxSidecar{}
+	if bundle == nil {
+		return sidecar, nil
+	}
+	if len(bundle.Blobs) != len(bundle.Commitments) || len(bundle.Blobs) != len(bundle.Proofs) {
+		return nil, fmt.Errorf(
+			"invalid blobs bundle: %d blobs, %d commitments, %d proofs",
+			len(bundle.Blobs), len(bundle.Commitments), len(bundle.Proofs),
+		)
+	}
+
+	sidecar.Blobs = make([]kzg4844.Blob, len(bundle.Blobs))
+	sidecar.Commitments = make([]kzg4844.Commitment, len(bundle.Commitments))
+	sidecar.Proofs = make([]kzg4844.Proof, len(bundle.Proofs))
+	for i := range bundle.Blobs {
+		if len(bundle.Blobs[i]) != len(kzg4844.Blob{}) ||
+			len(bundle.Commitments[i]) != len(kzg4844.Commitment{}) ||
+			len(bundle.Proofs[i]) != len(kzg4844.Proof{}) {
+			return nil, fmt.Errorf("invalid blobs bundle: malformed blob %d", i)
+		}
+		copy(sidecar.Blobs[i][:], bundle.Blobs[i])
+		copy(sidecar.Commitments[i][:], bundle.Commitments[i])
+		copy(sidecar.Proofs[i][:], bundle.Proofs[i])
+
+		if err := kzg4844.VerifyBlobProof(
+			sidecar.Blobs[i], sidecar.Commitments[i], sidecar.Proofs[i],
+		); err != nil {
+			return nil, fmt.Errorf("invalid blobs bundle: blob %d: %w", i, err)
+		}
+	}
+	return sidecar, nil
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package types
+
+import (
+	"encoding/binary"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// PrevRandaoSchedule reports whether the random value of the execution payload with the given
+// time is derived from consensus data, which is activated by the chain config stored on-chain.
+// The random value of the payloads before the activation is zero.
+type PrevRandaoSchedule interface {
+	IsPrevRandao(ctx sdk.Context, time uint64) bool
+}
+
+// PrevRandao returns the random value of the execution payload built on top of the block with
+// the given random value, and proposed by the given validator in the Cosmos block with the given
+// app hash and last commit votes. The value is exposed to contracts as `block.prevrandao`.
+//
+// The value is deterministic: every validator derives it from the same consensus data in both
+// PrepareProposal and ProcessProposal, and proposals that carry a different value are rejected.
+//
+// Security properties: the value chains the random value of every previous block with the set
+// of validators that signed the last commit, so it cannot be known before the previous block is
+// committed. It is however known to every validator from that point on, and the proposer can
+// bias it by choosing which of the votes beyond the +2/3 quorum to include in the last commit.
+// As with the RANDAO of Ethereum, it must not be relied upon where such a bias is profitable.
+func PrevRandao(
+	parentRandao common.Hash, appHash, proposer []byte, votes []abci.VoteInfo,
+) common.Hash {
+	var (
+		buf   = make([]byte, 0, 2*common.HashLength+len(proposer)+len(votes)*(20+8+4))
+		power [8]byte
+		flag  [4]byte
+	)
+	buf = append(buf, parentRandao.Bytes()...)
+	buf = append(buf, appHash...)
+	buf = append(buf, proposer...)
+	for _, vote := range votes {
+		binary.BigEndian.PutUint64(power[:], uint64(vote.Validator.Power))
+		binary.BigEndian.PutUint32(flag[:], uint32(vote.BlockIdFlag))
+		buf = append(buf, vote.Validator.Address...)
+		buf = append(buf, power[:]...)
+		buf = append(buf, flag[:]...)
+	}
+	return crypto.Keccak256Hash(buf)
+}
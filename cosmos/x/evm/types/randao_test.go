@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestTypes(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/x/evm/types")
+}
+
+var _ = Describe("PrevRandao", func() {
+	var (
+		parent   = common.HexToHash("0x4a")
+		appHash  = common.HexToHash("0xa9").Bytes()
+		proposer = common.HexToAddress("0xc0").Bytes()
+		votes    []abci.VoteInfo
+	)
+
+	BeforeEach(func() {
+		votes = []abci.VoteInfo{
+			{
+				Validator:   abci.Validator{Address: common.HexToAddress("0xc0").Bytes(), Power: 10},
+				BlockIdFlag: cmtproto.BlockIDFlagCommit,
+			},
+			{
+				Validator:   abci.Validator{Address: common.HexToAddress("0xc1").Bytes(), Power: 5},
+				BlockIdFlag: cmtproto.BlockIDFlagAbsent,
+			},
+		}
+	})
+
+	It("should be deterministic", func() {
+		expected := types.PrevRandao(parent, appHash, proposer, votes)
+		Expect(expected).ToNot(Equal(common.Hash{}))
+		Expect(types.PrevRandao(parent, appHash, proposer, votes)).To(Equal(expected))
+	})
+
+	It("should depend on every piece of consensus data", func() {
+		expected := types.PrevRandao(parent, appHash, proposer, votes)
+		Expect(types.PrevRandao(common.HexToHash("0x4b"), appHash, proposer, votes)).
+			ToNot(Equal(expected))
+		Expect(types.PrevRandao(parent, common.HexToHash("0xa8").Bytes(), proposer, votes)).
+			ToNot(Equal(expected))
+		Expect(types.PrevRandao(parent, appHash, common.HexToAddress("0xc1").Bytes(), votes)).
+			ToNot(Equal(expected))
+		Expect(types.PrevRandao(parent, appHash, proposer, votes[:1])).ToNot(Equal(expected))
+		Expect(types.PrevRandao(parent, appHash, proposer, []abci.VoteInfo{votes[1], votes[0]})).
+			ToNot(Equal(expected))
+
+		votes[1].BlockIdFlag = cmtproto.BlockIDFlagCommit
+		Expect(types.PrevRandao(parent, appHash, proposer, votes)).ToNot(Equal(expected))
+		votes[1].BlockIdFlag = cmtproto.BlockIDFlagAbsent
+		votes[1].Validator.Power = 6
+		Expect(types.PrevRandao(parent, appHash, proposer, votes)).ToNot(Equal(expected))
+	})
+})
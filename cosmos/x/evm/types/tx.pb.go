@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -194,37 +195,247 @@ func (m *WrappedEthereumTransactionResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WrappedEthereumTransactionResult proto.InternalMessageInfo
 
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module, which is the x/gov module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// chain_config is the JSON encoded EVM chain config. It must not change the rules of the
+	// blocks that were already produced.
+	ChainConfig []byte `protobuf:"bytes,2,opt,name=chain_config,json=chainConfig,proto3" json:"chain_config,omitempty"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{4}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetChainConfig() []byte {
+	if m != nil {
+		return m.ChainConfig
+	}
+	return nil
+}
+
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{5}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgScheduleHardfork is the Msg/ScheduleHardfork request type.
+type MsgScheduleHardfork struct {
+	// authority is the address that controls the module, which is the x/gov module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// name is the name of the time based hardfork, e.g. "cancun", "prague" or "prevrandao".
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// time is the unix timestamp at which the hardfork activates. It must be in the future.
+	Time uint64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (m *MsgScheduleHardfork) Reset()         { *m = MsgScheduleHardfork{} }
+func (m *MsgScheduleHardfork) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleHardfork) ProtoMessage()    {}
+func (*MsgScheduleHardfork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{6}
+}
+func (m *MsgScheduleHardfork) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleHardfork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleHardfork.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleHardfork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleHardfork.Merge(m, src)
+}
+func (m *MsgScheduleHardfork) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleHardfork) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleHardfork.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleHardfork proto.InternalMessageInfo
+
+func (m *MsgScheduleHardfork) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgScheduleHardfork) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgScheduleHardfork) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// MsgScheduleHardforkResponse defines the Msg/ScheduleHardfork response type.
+type MsgScheduleHardforkResponse struct {
+}
+
+func (m *MsgScheduleHardforkResponse) Reset()         { *m = MsgScheduleHardforkResponse{} }
+func (m *MsgScheduleHardforkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleHardforkResponse) ProtoMessage()    {}
+func (*MsgScheduleHardforkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{7}
+}
+func (m *MsgScheduleHardforkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleHardforkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleHardforkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleHardforkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleHardforkResponse.Merge(m, src)
+}
+func (m *MsgScheduleHardforkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleHardforkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleHardforkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleHardforkResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*WrappedEthereumTransaction)(nil), "polaris.evm.v1alpha1.WrappedEthereumTransaction")
 	proto.RegisterType((*WrappedPayloadEnvelope)(nil), "polaris.evm.v1alpha1.WrappedPayloadEnvelope")
 	proto.RegisterType((*WrappedPayloadEnvelopeResponse)(nil), "polaris.evm.v1alpha1.WrappedPayloadEnvelopeResponse")
 	proto.RegisterType((*WrappedEthereumTransactionResult)(nil), "polaris.evm.v1alpha1.WrappedEthereumTransactionResult")
+	proto.RegisterType((*MsgUpdateParams)(nil), "polaris.evm.v1alpha1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "polaris.evm.v1alpha1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgScheduleHardfork)(nil), "polaris.evm.v1alpha1.MsgScheduleHardfork")
+	proto.RegisterType((*MsgScheduleHardforkResponse)(nil), "polaris.evm.v1alpha1.MsgScheduleHardforkResponse")
 }
 
 func init() { proto.RegisterFile("polaris/evm/v1alpha1/tx.proto", fileDescriptor_d8b33d2a2c64400f) }
 
 var fileDescriptor_d8b33d2a2c64400f = []byte{
-	// 320 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x4a, 0x3b, 0x31,
-	0x10, 0xc7, 0xbb, 0x3f, 0x7e, 0x7a, 0x08, 0xe2, 0x61, 0x91, 0x2a, 0x0b, 0x86, 0xb2, 0x27, 0x91,
-	0x92, 0xb4, 0x2a, 0x3e, 0x80, 0xd0, 0x83, 0x07, 0xa1, 0x54, 0x41, 0xf0, 0x36, 0x4d, 0x87, 0xee,
-	0xc2, 0x66, 0x13, 0x32, 0x69, 0x68, 0xc5, 0x83, 0x2f, 0x20, 0xf8, 0x28, 0x3e, 0x86, 0xc7, 0x1e,
-	0x3d, 0x4a, 0x7b, 0xf0, 0x35, 0xc4, 0xfe, 0x01, 0x29, 0xad, 0xd0, 0x53, 0x02, 0xf3, 0x99, 0xe1,
-	0x3b, 0xc3, 0x87, 0x1d, 0x5b, 0x53, 0x80, 0xcb, 0x49, 0x62, 0xd0, 0x32, 0x34, 0xa1, 0xb0, 0x19,
-	0x34, 0xa5, 0x1f, 0x0a, 0xeb, 0x8c, 0x37, 0xf1, 0xc1, 0xa2, 0x2c, 0x30, 0x68, 0xb1, 0x2c, 0x27,
-	0x87, 0xca, 0x90, 0x36, 0x24, 0x35, 0xf5, 0x65, 0x68, 0xfe, 0x3c, 0x73, 0x3c, 0x6d, 0xb0, 0xe4,
-	0xde, 0x81, 0xb5, 0xd8, 0x6b, 0xf9, 0x0c, 0x1d, 0x0e, 0xf4, 0x9d, 0x83, 0x92, 0x40, 0xf9, 0xdc,
-	0x94, 0x71, 0xcc, 0xfe, 0xf7, 0xc0, 0xc3, 0x51, 0x54, 0x8b, 0x4e, 0xf6, 0x3a, 0xb3, 0x7f, 0x5a,
-	0x67, 0xd5, 0x45, 0x47, 0x1b, 0x46, 0x85, 0x81, 0x5e, 0xab, 0x0c, 0x58, 0x18, 0x8b, 0x6b, 0xe9,
-	0x1a, 0xe3, 0xeb, 0xe9, 0x0e, 0x92, 0x35, 0x25, 0x61, 0x9a, 0xb2, 0xda, 0xe6, 0x04, 0x1d, 0xa4,
-	0x41, 0xe1, 0xcf, 0x5e, 0xfe, 0x31, 0x76, 0x43, 0xfd, 0x5b, 0x74, 0x21, 0x57, 0x18, 0x3f, 0xb2,
-	0xfd, 0x96, 0xcf, 0x7e, 0x07, 0x6d, 0x88, 0x75, 0x6b, 0x8b, 0xcd, 0x83, 0x93, 0xcb, 0x6d, 0x3b,
-	0xe6, 0x51, 0xe2, 0x27, 0x56, 0x6d, 0x3b, 0xa3, 0x90, 0x68, 0x75, 0xfd, 0xfa, 0x9f, 0x13, 0x57,
-	0xe8, 0xe4, 0x62, 0x1b, 0x7a, 0x79, 0xac, 0x64, 0xe7, 0xf9, 0xeb, 0xed, 0x34, 0xba, 0xba, 0x7e,
-	0x9f, 0xf0, 0x68, 0x3c, 0xe1, 0xd1, 0xe7, 0x84, 0x47, 0xaf, 0x53, 0x5e, 0x19, 0x4f, 0x79, 0xe5,
-	0x63, 0xca, 0x2b, 0x0f, 0xb2, 0x9f, 0xfb, 0x6c, 0xd0, 0x15, 0xca, 0x68, 0xd9, 0x45, 0x07, 0x2a,
-	0x83, 0xbc, 0x94, 0x4b, 0x65, 0x16, 0x16, 0x0c, 0x67, 0xee, 0xf8, 0x91, 0x45, 0xea, 0xee, 0xce,
-	0x3c, 0x38, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x9f, 0x14, 0xe7, 0x57, 0x02, 0x00, 0x00,
+	// 510 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x41, 0x6b, 0xd4, 0x50,
+	0x10, 0xde, 0xd8, 0x55, 0xd8, 0x71, 0xa9, 0x12, 0x4b, 0xbb, 0x8d, 0x34, 0xac, 0x01, 0xa1, 0x96,
+	0x36, 0xe9, 0xaa, 0xf4, 0xe0, 0xcd, 0xca, 0x82, 0x1e, 0x0a, 0x4b, 0xaa, 0x08, 0x5e, 0xca, 0xdb,
+	0x64, 0x9a, 0x04, 0x93, 0xbc, 0xc7, 0x7b, 0x2f, 0xa1, 0x2b, 0x3d, 0x88, 0xe0, 0xdd, 0x9f, 0xd2,
+	0x83, 0x3f, 0xc2, 0x63, 0xf1, 0xe4, 0x51, 0x76, 0x91, 0xfe, 0x0d, 0xd9, 0x97, 0x4d, 0xab, 0x69,
+	0x56, 0x5a, 0x4f, 0x3b, 0x6f, 0xbe, 0x6f, 0x66, 0xbe, 0xcd, 0x7c, 0x0c, 0xac, 0x31, 0x1a, 0x13,
+	0x1e, 0x09, 0x07, 0xf3, 0xc4, 0xc9, 0x7b, 0x24, 0x66, 0x21, 0xe9, 0x39, 0xf2, 0xc8, 0x66, 0x9c,
+	0x4a, 0xaa, 0x2f, 0xcd, 0x60, 0x1b, 0xf3, 0xc4, 0x2e, 0x61, 0x63, 0xc5, 0xa3, 0x22, 0xa1, 0xc2,
+	0x49, 0x44, 0xe0, 0xe4, 0xbd, 0xe9, 0x4f, 0x41, 0x37, 0x56, 0x0b, 0xe0, 0x40, 0xbd, 0x9c, 0xe2,
+	0x51, 0x40, 0xd6, 0x36, 0x18, 0x6f, 0x39, 0x61, 0x0c, 0xfd, 0xbe, 0x0c, 0x91, 0x63, 0x96, 0xbc,
+	0xe6, 0x24, 0x15, 0xc4, 0x93, 0x11, 0x4d, 0x75, 0x1d, 0x9a, 0x3e, 0x91, 0xa4, 0xa3, 0x75, 0xb5,
+	0xf5, 0xb6, 0xab, 0x62, 0x6b, 0x13, 0x96, 0x67, 0x15, 0x03, 0x32, 0x8a, 0x29, 0xf1, 0xfb, 0x69,
+	0x8e, 0x31, 0x65, 0x58, 0xcb, 0xee, 0x82, 0x59, 0xcf, 0x76, 0x51, 0x30, 0x9a, 0x0a, 0xb4, 0x2c,
+	0xe8, 0xce, 0x57, 0xe0, 0xa2, 0xc8, 0x62, 0x69, 0x1d, 0xc3, 0x9d, 0x3d, 0x11, 0xbc, 0x61, 0x3e,
+	0x91, 0x38, 0x20, 0x9c, 0x24, 0x42, 0xdf, 0x81, 0x16, 0xc9, 0x64, 0x48, 0x79, 0x24, 0x47, 0x6a,
+	0x62, 0x6b, 0xb7, 0xf3, 0xfd, 0xeb, 0xd6, 0xd2, 0xec, 0xdf, 0x3d, 0xf7, 0x7d, 0x8e, 0x42, 0xec,
+	0x4b, 0x1e, 0xa5, 0x81, 0x7b, 0x41, 0xd5, 0x1f, 0x40, 0xdb, 0x0b, 0x49, 0x94, 0x1e, 0x78, 0x34,
+	0x3d, 0x8c, 0x82, 0xce, 0x0d, 0x25, 0xf6, 0xb6, 0xca, 0xbd, 0x50, 0xa9, 0x67, 0x8b, 0x9f, 0xce,
+	0x4e, 0x36, 0x2e, 0x4a, 0xac, 0x55, 0x58, 0xa9, 0x4c, 0x3f, 0x17, 0xff, 0x59, 0x83, 0x7b, 0x7b,
+	0x22, 0xd8, 0xf7, 0x42, 0xf4, 0xb3, 0x18, 0x5f, 0x12, 0xee, 0x1f, 0x52, 0xfe, 0xfe, 0xbf, 0xd5,
+	0xe9, 0xd0, 0x4c, 0x49, 0x82, 0x4a, 0x55, 0xcb, 0x55, 0xf1, 0x34, 0x27, 0xa3, 0x04, 0x3b, 0x0b,
+	0x5d, 0x6d, 0xbd, 0xe9, 0xaa, 0xf8, 0x92, 0xc4, 0x35, 0xb8, 0x5f, 0x23, 0xa3, 0x94, 0xf9, 0xf8,
+	0xd7, 0x02, 0xc0, 0x14, 0x47, 0x9e, 0x47, 0x1e, 0xea, 0x1f, 0x60, 0xb1, 0x2f, 0xc3, 0x3f, 0x17,
+	0xbd, 0x6d, 0xd7, 0x39, 0xca, 0x9e, 0xbf, 0x18, 0x63, 0xe7, 0xba, 0x15, 0xc5, 0x2a, 0xf5, 0x63,
+	0x58, 0x1e, 0x70, 0xea, 0xa1, 0x10, 0x55, 0xfb, 0x6c, 0xfe, 0xb3, 0x63, 0x85, 0x6d, 0x3c, 0xbd,
+	0x0e, 0xbb, 0xfc, 0x10, 0xba, 0x0f, 0xed, 0xbf, 0x5c, 0xf4, 0xb0, 0xbe, 0x4b, 0x65, 0xdd, 0xc6,
+	0xd6, 0x95, 0x68, 0xe7, 0x53, 0x18, 0xdc, 0xbd, 0xe4, 0x88, 0x47, 0x73, 0x5b, 0x54, 0xa9, 0x46,
+	0xef, 0xca, 0xd4, 0x72, 0xa2, 0x71, 0xf3, 0xe3, 0xd9, 0xc9, 0x86, 0xb6, 0xfb, 0xea, 0xdb, 0xd8,
+	0xd4, 0x4e, 0xc7, 0xa6, 0xf6, 0x73, 0x6c, 0x6a, 0x5f, 0x26, 0x66, 0xe3, 0x74, 0x62, 0x36, 0x7e,
+	0x4c, 0xcc, 0xc6, 0x3b, 0x27, 0x88, 0x64, 0x98, 0x0d, 0x6d, 0x8f, 0x26, 0xce, 0x10, 0x39, 0x51,
+	0x7e, 0x77, 0xca, 0x2b, 0x33, 0x3b, 0x1c, 0x47, 0xea, 0xdc, 0xc8, 0x11, 0x43, 0x31, 0xbc, 0xa5,
+	0xee, 0xc3, 0x93, 0xdf, 0x03, 0x00, 0xcc, 0xd3, 0x36, 0xf3, 0x8a, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -243,6 +454,10 @@ type MsgServiceClient interface {
 	EthTransaction(ctx context.Context, in *WrappedEthereumTransaction, opts ...grpc.CallOption) (*WrappedEthereumTransactionResult, error)
 	// ProcessPayloadEnvelope defines a method to process CL paylods.
 	ProcessPayloadEnvelope(ctx context.Context, in *WrappedPayloadEnvelope, opts ...grpc.CallOption) (*WrappedPayloadEnvelopeResponse, error)
+	// UpdateParams defines a governance operation for updating the EVM chain config.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ScheduleHardfork defines a governance operation for scheduling a time based hardfork.
+	ScheduleHardfork(ctx context.Context, in *MsgScheduleHardfork, opts ...grpc.CallOption) (*MsgScheduleHardforkResponse, error)
 }
 
 type msgServiceClient struct {
@@ -271,12 +486,34 @@ func (c *msgServiceClient) ProcessPayloadEnvelope(ctx context.Context, in *Wrapp
 	return out, nil
 }
 
+func (c *msgServiceClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/polaris.evm.v1alpha1.MsgService/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) ScheduleHardfork(ctx context.Context, in *MsgScheduleHardfork, opts ...grpc.CallOption) (*MsgScheduleHardforkResponse, error) {
+	out := new(MsgScheduleHardforkResponse)
+	err := c.cc.Invoke(ctx, "/polaris.evm.v1alpha1.MsgService/ScheduleHardfork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 type MsgServiceServer interface {
 	// EthTransaction defines a method submitting Ethereum transactions.
 	EthTransaction(context.Context, *WrappedEthereumTransaction) (*WrappedEthereumTransactionResult, error)
 	// ProcessPayloadEnvelope defines a method to process CL paylods.
 	ProcessPayloadEnvelope(context.Context, *WrappedPayloadEnvelope) (*WrappedPayloadEnvelopeResponse, error)
+	// UpdateParams defines a governance operation for updating the EVM chain config.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ScheduleHardfork defines a governance operation for scheduling a time based hardfork.
+	ScheduleHardfork(context.Context, *MsgScheduleHardfork) (*MsgScheduleHardforkResponse, error)
 }
 
 // UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
@@ -289,6 +526,12 @@ func (*UnimplementedMsgServiceServer) EthTransaction(ctx context.Context, req *W
 func (*UnimplementedMsgServiceServer) ProcessPayloadEnvelope(ctx context.Context, req *WrappedPayloadEnvelope) (*WrappedPayloadEnvelopeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessPayloadEnvelope not implemented")
 }
+func (*UnimplementedMsgServiceServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServiceServer) ScheduleHardfork(ctx context.Context, req *MsgScheduleHardfork) (*MsgScheduleHardforkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleHardfork not implemented")
+}
 
 func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
 	s.RegisterService(&_MsgService_serviceDesc, srv)
@@ -330,6 +573,42 @@ func _MsgService_ProcessPayloadEnvelope_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polaris.evm.v1alpha1.MsgService/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_ScheduleHardfork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleHardfork)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).ScheduleHardfork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polaris.evm.v1alpha1.MsgService/ScheduleHardfork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ScheduleHardfork(ctx, req.(*MsgScheduleHardfork))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MsgService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "polaris.evm.v1alpha1.MsgService",
 	HandlerType: (*MsgServiceServer)(nil),
@@ -342,6 +621,14 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessPayloadEnvelope",
 			Handler:    _MsgService_ProcessPayloadEnvelope_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _MsgService_UpdateParams_Handler,
+		},
+		{
+			MethodName: "ScheduleHardfork",
+			Handler:    _MsgService_ScheduleHardfork_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "polaris/evm/v1alpha1/tx.proto",
@@ -453,6 +740,131 @@ func (m *WrappedEthereumTransactionResult) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainConfig) > 0 {
+		i -= len(m.ChainConfig)
+		copy(dAtA[i:], m.ChainConfig)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainConfig)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleHardfork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleHardfork) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleHardfork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleHardforkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleHardforkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleHardforkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -508,10 +920,65 @@ func (m *WrappedEthereumTransactionResult) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainConfig)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleHardfork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovTx(uint64(m.Time))
+	}
+	return n
+}
+
+func (m *MsgScheduleHardforkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
@@ -782,6 +1249,355 @@ func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainConfig = append(m.ChainConfig[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChainConfig == nil {
+				m.ChainConfig = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleHardfork) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleHardfork: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleHardfork: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleHardforkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleHardforkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleHardforkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -46,8 +46,11 @@ import (
 	"github.com/berachain/polaris/cosmos/runtime/miner"
 	evmkeeper "github.com/berachain/polaris/cosmos/x/evm/keeper"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -130,6 +133,8 @@ func NewPolarisApp(
 	var (
 		app        = &SimApp{}
 		appBuilder *runtime.AppBuilder
+		// read the polaris config once for the app config and the runtime
+		polarisConfig = evmconfig.MustReadConfigFromAppOpts(appOpts)
 		// merge the AppConfig and other configuration in one config
 		appConfig = depinject.Configs(
 			MakeAppConfig(bech32Prefix),
@@ -143,7 +148,8 @@ func NewPolarisApp(
 				// supply the logger
 				logger,
 				// ADVANCED CONFIGURATION\
-				PolarisConfigFn(evmconfig.MustReadConfigFromAppOpts(appOpts)),
+				PolarisConfigFn(polarisConfig),
+				CustomSignModeHandlersFn(app),
 				PrecompilesToInject(app),
 				QueryContextFn(app),
 				//
@@ -192,7 +198,6 @@ func NewPolarisApp(
 		panic(err)
 	}
 
-	polarisConfig := evmconfig.MustReadConfigFromAppOpts(appOpts)
 	if polarisConfig.OptimisticExecution {
 		baseAppOptions = append(baseAppOptions, baseapp.SetOptimisticExecution())
 	}
@@ -203,8 +208,13 @@ func NewPolarisApp(
 		polarisConfig, app.Logger(), app.EVMKeeper.Host, nil,
 	)
 
+	// Apply the runtime-safe settings of app.toml whenever the node receives SIGHUP.
+	if home, ok := appOpts.Get(flags.FlagHome).(string); ok && home != "" {
+		app.Polaris.ReloadConfigOnSignal(home)
+	}
+
 	// Build cosmos ante handler for non-evm transactions.
-	cosmHandler, err := authante.NewAnteHandler(
+	cosmHandler, err := ante.NewCosmosAnteHandler(
 		authante.HandlerOptions{
 			AccountKeeper:   app.AccountKeeper,
 			BankKeeper:      app.BankKeeper,
@@ -222,7 +232,8 @@ func NewPolarisApp(
 
 	// Setup Polaris Runtime.
 	if err = app.Polaris.Build(
-		app, cosmHandler, app.EVMKeeper, miner.DefaultAllowedMsgs, nil, polarabci.NoopPrepareProposalHook,
+		app, cosmHandler, app.EVMKeeper, app.StakingKeeper, miner.DefaultAllowedMsgs, nil,
+		polarabci.NoopPrepareProposalHook,
 	); err != nil {
 		panic(err)
 	}
@@ -300,6 +311,19 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 	}
 }
 
+// FinalizeBlock implements abci.Application, streaming the results of each finalized block to
+// the Polaris subscriptions.
+func (app *SimApp) FinalizeBlock(
+	req *abci.RequestFinalizeBlock,
+) (*abci.ResponseFinalizeBlock, error) {
+	res, err := app.BaseApp.FinalizeBlock(req)
+	if err != nil {
+		return nil, err
+	}
+	app.Polaris.EventFeed.FinalizeBlock(req, res)
+	return res, nil
+}
+
 // Close shuts down the application.
 func (app *SimApp) Close() error {
 	if pl := app.Polaris; pl != nil {
@@ -24,7 +24,13 @@
 package testapp
 
 import (
+	"context"
+	"math/big"
+
+	txsigning "cosmossdk.io/x/tx/signing"
+
 	evmconfig "github.com/berachain/polaris/cosmos/config"
+	signinglib "github.com/berachain/polaris/cosmos/lib/signing"
 	bankprecompile "github.com/berachain/polaris/cosmos/precompile/bank"
 	distrprecompile "github.com/berachain/polaris/cosmos/precompile/distribution"
 	govprecompile "github.com/berachain/polaris/cosmos/precompile/governance"
@@ -89,3 +95,15 @@ func PolarisConfigFn(cfg *evmconfig.Config) func() *evmconfig.Config {
 		return cfg
 	}
 }
+
+// CustomSignModeHandlersFn returns a function that provides the EIP-712 sign mode handler, for
+// the EVM chain ID of the on-chain chain config.
+func CustomSignModeHandlersFn(app *SimApp) func() []txsigning.SignModeHandler {
+	return func() []txsigning.SignModeHandler {
+		return []txsigning.SignModeHandler{
+			signinglib.NewEIP712SignModeHandler(func(ctx context.Context) (*big.Int, error) {
+				return app.EVMKeeper.EVMChainID(ctx)
+			}),
+		}
+	}
+}
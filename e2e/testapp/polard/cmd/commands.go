@@ -36,6 +36,7 @@ import (
 	confixcmd "cosmossdk.io/tools/confix/cmd"
 
 	polarconfig "github.com/berachain/polaris/cosmos/config"
+	evmcli "github.com/berachain/polaris/cosmos/x/evm/client/cli"
 	testapp "github.com/berachain/polaris/e2e/testapp"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -87,23 +88,32 @@ func initRootCmd(
 	cfg := sdk.GetConfig()
 	cfg.Seal()
 
+	configCmd := confixcmd.ConfigCommand()
+	configCmd.AddCommand(evmcli.ValidateConfigCmd())
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(basicManager, testapp.DefaultNodeHome),
 		debug.Cmd(),
-		confixcmd.ConfigCommand(),
+		configCmd,
 		pruning.Cmd(newApp, testapp.DefaultNodeHome),
 		snapshot.Cmd(newApp),
+		evmcli.MigrateHistoricalDBCmd(),
+		evmcli.ExportGenesisStreamCmd(evmExport),
 	)
 
 	server.AddCommands(rootCmd, testapp.DefaultNodeHome, newApp, appExport, addModuleInitFlags)
+	if exportCmd, _, err := rootCmd.Find([]string{"export"}); err == nil {
+		evmcli.AddGenesisStreamFlag(exportCmd)
+	}
 
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	rootCmd.AddCommand(
 		server.StatusCommand(),
-		genesisCommand(txConfig, basicManager),
+		genesisCommand(txConfig, basicManager, evmcli.ValidateGenesisCmd()),
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
+		evmcli.EVMCommand(),
 	)
 }
 
@@ -229,6 +239,18 @@ func appExport(
 	return testApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
 }
 
+// evmExport creates a new simapp and streams its evm genesis state at the given height.
+func evmExport(
+	logger log.Logger,
+	db dbm.DB,
+	height int64,
+	appOpts servertypes.AppOptions,
+	w io.Writer,
+) (string, error) {
+	testApp := testapp.NewPolarisApp(logger, db, nil, true, "", appOpts)
+	return testApp.EVMKeeper.ExportGenesisStreamAtHeight(height, w)
+}
+
 var tempDir = func() string {
 	dir, err := os.MkdirTemp("", ".polard")
 	if err != nil {
@@ -33,12 +33,14 @@ import (
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	txsigning "cosmossdk.io/x/tx/signing"
 
 	evmv1alpha1 "github.com/berachain/polaris/cosmos/api/polaris/evm/v1alpha1"
 	polarconfig "github.com/berachain/polaris/cosmos/config"
 	ethcryptocodec "github.com/berachain/polaris/cosmos/crypto/codec"
 	polarkeyring "github.com/berachain/polaris/cosmos/crypto/keyring"
 	signinglib "github.com/berachain/polaris/cosmos/lib/signing"
+	"github.com/berachain/polaris/cosmos/x/evm/plugins/historical"
 	testapp "github.com/berachain/polaris/e2e/testapp"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -68,7 +70,15 @@ func NewRootCmd() *cobra.Command {
 		depinject.Configs(
 			testapp.MakeAppConfig(""),
 			depinject.Supply(
-				testapp.PolarisConfigFn(polarconfig.DefaultPolarisConfig()),
+				testapp.PolarisConfigFn(clientPolarisConfig()),
+				// The client has no chain state, so it signs for the default EVM chain ID.
+				func() []txsigning.SignModeHandler {
+					return []txsigning.SignModeHandler{
+						signinglib.NewEIP712SignModeHandler(signinglib.StaticChainID(
+							polarconfig.DefaultPolarisConfig().Polar.Chain.ChainID,
+						)),
+					}
+				},
 				testapp.QueryContextFn((&testapp.SimApp{})),
 				log.NewNopLogger(),
 				simtestutil.NewAppOptionsWithFlagHome(tempDir()),
@@ -110,6 +120,8 @@ func NewRootCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			// Sign the EIP-712 sign mode with Ledger keys as well.
+			clientCtx = clientCtx.WithKeyring(polarkeyring.NewEIP712Keyring(clientCtx.Keyring))
 
 			if err = client.SetCmdClientContextHandler(clientCtx, cmd); err != nil {
 				return err
@@ -133,6 +145,14 @@ func NewRootCmd() *cobra.Command {
 	return rootCmd
 }
 
+// clientPolarisConfig returns the polaris config of the client app, which has no node home and
+// so keeps its historical data in memory.
+func clientPolarisConfig() *polarconfig.Config {
+	cfg := polarconfig.DefaultPolarisConfig()
+	cfg.Polar.HistoricalDB.Backend = historical.BackendMemory
+	return cfg
+}
+
 func ProvideClientContext(
 	appCodec codec.Codec,
 	interfaceRegistry codectypes.InterfaceRegistry,
@@ -45,9 +45,10 @@ var _ Engine = (*DummyEthOne)(nil)
 // DummyEthOne is a mock implementation of the Engine interface.
 type DummyEthOne struct{}
 
-// Author is a mock implementation.
+// Author returns the coinbase of the header, which the host chain sets to the address of the
+// block's proposer.
 func (m *DummyEthOne) Author(header *ethtypes.Header) (common.Address, error) {
-	return common.Address{}, nil
+	return header.Coinbase, nil
 }
 
 // VerifyHeader is a mock implementation.
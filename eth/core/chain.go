@@ -35,8 +35,10 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/lru"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
@@ -76,8 +78,8 @@ type blockchain struct {
 	// vmConfig is the configuration used to create the EVM.
 	vmConfig *vm.Config
 
-	// config represents the chain config.
-	config *params.ChainConfig
+	// config represents the chain config, which is replaced as a whole when it is updated.
+	config atomic.Pointer[params.ChainConfig]
 
 	// currentBlock is the current/pending block.
 	currentBlock atomic.Pointer[ethtypes.Block]
@@ -96,6 +98,12 @@ type blockchain struct {
 	// txLookupCache is a cache of the transactions for the last `defaultCacheSizeBytes` bytes of
 	// blocks. txHash -> txLookupEntry
 	txLookupCache *lru.Cache[common.Hash, *types.TxLookupEntry]
+	// earliestBlock is the earliest block whose historical data had not been pruned when the
+	// caches were last checked, which are purged whenever it advances.
+	earliestBlock atomic.Uint64
+
+	// badBlocks stores the most recent blocks that were rejected by the chain.
+	badBlocks ethdb.Database
 
 	// subscription event feeds
 	scope         event.SubscriptionScope
@@ -112,27 +120,31 @@ type blockchain struct {
 // =========================================================================
 
 // NewChain creates and returns a `api.Chain` with the given EVM chain configuration and host.
+// Rejected blocks are stored in badBlockDB, or in memory if it is nil.
 func NewChain(
 	host PolarisHostChain, config *params.ChainConfig, engine consensus.Engine,
+	badBlockDB ethdb.Database,
 ) *blockchain { //nolint:revive // only used as `api.Chain`.
+	if badBlockDB == nil {
+		badBlockDB = rawdb.NewMemoryDatabase()
+	}
 	bc := &blockchain{
 		bp:             host.GetBlockPlugin(),
 		hp:             host.GetHistoricalPlugin(),
 		pp:             host.GetPrecompilePlugin(),
 		spf:            host.GetStatePluginFactory(),
-		config:         config,
 		vmConfig:       &vm.Config{},
 		receiptsCache:  lru.NewCache[common.Hash, ethtypes.Receipts](defaultCacheSize),
 		blockNumCache:  lru.NewCache[uint64, *ethtypes.Block](defaultCacheSize),
 		blockHashCache: lru.NewCache[common.Hash, *ethtypes.Block](defaultCacheSize),
 		txLookupCache:  lru.NewCache[common.Hash, *types.TxLookupEntry](defaultCacheSize),
+		badBlocks:      badBlockDB,
 		chainHeadFeed:  event.Feed{},
 		scope:          event.SubscriptionScope{},
 		logger:         log.Root(),
 		engine:         engine,
 	}
-	bc.processor = core.NewStateProcessor(bc.config, bc, bc.engine)
-	bc.validator = core.NewBlockValidator(bc.config, bc, bc.engine)
+	bc.SetConfig(config)
 	// TODO: bug fix required.
 	bc.currentBlock.Store(
 		ethtypes.NewBlock(&ethtypes.Header{Time: 0, Number: big.NewInt(0),
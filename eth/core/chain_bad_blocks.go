@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package core
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+)
+
+// badBlockReasonPrefix prefixes the reject reason of a bad block, keyed by the block hash.
+var badBlockReasonPrefix = []byte("polaris-bad-block-reason-")
+
+// BadBlock is an EVM block that was rejected by the chain, along with the reason why.
+type BadBlock struct {
+	Block  *ethtypes.Block
+	Reason string
+}
+
+// BadBlockDB returns the database that rejected blocks are stored in. It is laid out like
+// geth's chain database, so that bad blocks can be read by the standard tracers.
+func (bc *blockchain) BadBlockDB() ethdb.Database {
+	return bc.badBlocks
+}
+
+// BadBlocks returns the most recently rejected blocks, ordered by descending block number.
+func (bc *blockchain) BadBlocks() []*BadBlock {
+	blocks := rawdb.ReadAllBadBlocks(bc.badBlocks)
+	badBlocks := make([]*BadBlock, len(blocks))
+	for i, block := range blocks {
+		reason, _ := bc.badBlocks.Get(badBlockReasonKey(block.Hash()))
+		badBlocks[i] = &BadBlock{Block: block, Reason: string(reason)}
+	}
+	return badBlocks
+}
+
+// reportBadBlock stores a block that was rejected by the chain along with the reason why. Only
+// the most recent bad blocks are kept, so the reasons of evicted blocks are dropped.
+func (bc *blockchain) reportBadBlock(block *ethtypes.Block, reason error) {
+	rawdb.WriteBadBlock(bc.badBlocks, block)
+	if err := bc.badBlocks.Put(
+		badBlockReasonKey(block.Hash()), []byte(reason.Error()),
+	); err != nil {
+		bc.logger.Error("failed to write bad block reason", "hash", block.Hash(), "err", err)
+	}
+
+	kept := make(map[common.Hash]struct{})
+	for _, b := range rawdb.ReadAllBadBlocks(bc.badBlocks) {
+		kept[b.Hash()] = struct{}{}
+	}
+	it := bc.badBlocks.NewIterator(badBlockReasonPrefix, nil)
+	defer it.Release()
+	for it.Next() {
+		if _, ok := kept[common.BytesToHash(it.Key()[len(badBlockReasonPrefix):])]; !ok {
+			_ = bc.badBlocks.Delete(it.Key())
+		}
+	}
+}
+
+// badBlockReasonKey returns the key of the reject reason of the bad block with the given hash.
+func badBlockReasonKey(hash common.Hash) []byte {
+	return append(append([]byte{}, badBlockReasonPrefix...), hash.Bytes()...)
+}
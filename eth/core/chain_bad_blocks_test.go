@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package core
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bad Blocks", func() {
+	var bc *blockchain
+
+	newBlock := func(number int64) *ethtypes.Block {
+		return ethtypes.NewBlockWithHeader(&ethtypes.Header{Number: big.NewInt(number)})
+	}
+
+	BeforeEach(func() {
+		bc = &blockchain{badBlocks: rawdb.NewMemoryDatabase(), logger: log.Root()}
+	})
+
+	It("should store rejected blocks with their reason", func() {
+		block := newBlock(1)
+		bc.reportBadBlock(block, errors.New("invalid gas used"))
+
+		badBlocks := bc.BadBlocks()
+		Expect(badBlocks).To(HaveLen(1))
+		Expect(badBlocks[0].Block.Hash()).To(Equal(block.Hash()))
+		Expect(badBlocks[0].Reason).To(Equal("invalid gas used"))
+		Expect(rawdb.ReadBadBlock(bc.BadBlockDB(), block.Hash())).ToNot(BeNil())
+	})
+
+	It("should only keep the most recent bad blocks", func() {
+		evicted := newBlock(1)
+		bc.reportBadBlock(evicted, errors.New("evicted"))
+		for i := int64(2); i <= 12; i++ {
+			bc.reportBadBlock(newBlock(i), errors.New("rejected"))
+		}
+
+		badBlocks := bc.BadBlocks()
+		Expect(badBlocks).To(HaveLen(10))
+		Expect(badBlocks[0].Block.NumberU64()).To(Equal(uint64(12)))
+		_, err := bc.badBlocks.Get(badBlockReasonKey(evicted.Hash()))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -24,6 +24,7 @@
 package core
 
 import (
+	"errors"
 	"math/big"
 
 	"github.com/berachain/polaris/eth/core/types"
@@ -31,6 +32,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
 )
 
 // ChainReader defines methods that are used to read the state and blocks of the chain.
@@ -45,15 +47,20 @@ type ChainBlockReader interface {
 	CurrentBlock() *ethtypes.Header
 	CurrentFinalBlock() *ethtypes.Header
 	CurrentSafeBlock() *ethtypes.Header
+	EarliestBlockNumber() uint64
 	GetBlock(common.Hash, uint64) *ethtypes.Block
 	GetReceiptsByHash(common.Hash) ethtypes.Receipts
+	GetBlobSidecarsByHash(common.Hash) []*ethtypes.BlobTxSidecar
 	GetBlockByHash(common.Hash) *ethtypes.Block
 	GetHeaderByNumber(uint64) *ethtypes.Header
 	GetHeaderByHash(common.Hash) *ethtypes.Header
 	GetBlockByNumber(uint64) *ethtypes.Block
 	GetTransactionLookup(common.Hash) *types.TxLookupEntry
+	HistoryPruned(common.Hash) bool
 	GetTd(common.Hash, uint64) *big.Int
 	HasBlock(common.Hash, uint64) bool
+	BadBlocks() []*BadBlock
+	BadBlockDB() ethdb.Database
 }
 
 // =========================================================================
@@ -105,6 +112,47 @@ func (bc *blockchain) CurrentSafeBlock() *ethtypes.Header {
 	return bc.CurrentFinalBlock()
 }
 
+// EarliestBlockNumber returns the number of the earliest block whose body, receipts and
+// transactions have not been pruned.
+func (bc *blockchain) EarliestBlockNumber() uint64 {
+	if bc.hp == nil {
+		return 0
+	}
+	return bc.hp.EarliestBlockNumber()
+}
+
+// HistoryPruned returns whether the historical data of the block or transaction with the given
+// hash has been pruned.
+func (bc *blockchain) HistoryPruned(hash common.Hash) bool {
+	if bc.hp == nil {
+		return false
+	}
+	if _, err := bc.hp.GetBlockByHash(hash); errors.Is(err, ErrHistoryPruned) {
+		return true
+	}
+	_, err := bc.hp.GetTransactionByHash(hash)
+	return errors.Is(err, ErrHistoryPruned)
+}
+
+// syncEarliestBlock returns the number of the earliest block whose historical data has not been
+// pruned, purging the caches of blocks, receipts and transactions whenever it advances.
+func (bc *blockchain) syncEarliestBlock() uint64 {
+	earliest := bc.EarliestBlockNumber()
+	if prev := bc.earliestBlock.Swap(earliest); earliest > prev {
+		bc.blockNumCache.Purge()
+		bc.blockHashCache.Purge()
+		bc.receiptsCache.Purge()
+		bc.txLookupCache.Purge()
+	}
+	return earliest
+}
+
+// isPruned returns whether the block with the given number is before the given earliest block.
+// The genesis block is never pruned.
+func isPruned(number, earliest uint64) bool {
+	return number > 0 && number < earliest
+}
+
 // GetBlock returns a block by its hash or number.
 func (bc *blockchain) GetBlock(hash common.Hash, number uint64) *ethtypes.Block {
 	if block := bc.GetBlockByHash(hash); block != nil {
@@ -117,7 +165,12 @@ func (bc *blockchain) GetBlock(hash common.Hash, number uint64) *ethtypes.Block
 // GetBlockByHash retrieves a block from the database by hash, caching it if found.
 func (bc *blockchain) GetBlockByHash(hash common.Hash) *ethtypes.Block {
 	// check the block hash cache
+	earliest := bc.syncEarliestBlock()
 	if block, ok := bc.blockHashCache.Get(hash); ok {
+		if isPruned(block.NumberU64(), earliest) {
+			bc.blockHashCache.Remove(hash)
+			return nil
+		}
 		bc.blockNumCache.Add(block.Number().Uint64(), block)
 		return block
 	}
@@ -144,6 +197,10 @@ func (bc *blockchain) GetBlockByHash(hash common.Hash) *ethtypes.Block {
 // GetBlock retrieves a block from the database by hash and number, caching it if found.
 func (bc *blockchain) GetBlockByNumber(number uint64) *ethtypes.Block {
 	// check the block number cache
+	if isPruned(number, bc.syncEarliestBlock()) {
+		bc.blockNumCache.Remove(number)
+		return nil
+	}
 	if block, ok := bc.blockNumCache.Get(number); ok {
 		bc.blockHashCache.Add(block.Hash(), block)
 		return block
@@ -182,6 +239,7 @@ func (bc *blockchain) GetBlockByNumber(number uint64) *ethtypes.Block {
 // the given hash.
 func (bc *blockchain) GetReceiptsByHash(blockHash common.Hash) ethtypes.Receipts {
 	// check the cache
+	bc.syncEarliestBlock()
 	if receipts, ok := bc.receiptsCache.Get(blockHash); ok {
 		derived, err := bc.deriveReceipts(receipts, blockHash)
 		if err != nil {
@@ -216,6 +274,23 @@ func (bc *blockchain) GetReceiptsByHash(blockHash common.Hash) ethtypes.Receipts
 	return derived
 }
 
+// GetBlobSidecarsByHash returns the blob sidecars of the blob transactions included in the block
+// defined by the given hash. Sidecars that have been pruned by the host chain are not returned.
+func (bc *blockchain) GetBlobSidecarsByHash(blockHash common.Hash) []*ethtypes.BlobTxSidecar {
+	// check if historical plugin is supported by host chain
+	if bc.hp == nil {
+		bc.logger.Debug("historical plugin not supported by host chain")
+		return nil
+	}
+
+	sidecars, err := bc.hp.GetBlobSidecarsByHash(blockHash)
+	if err != nil {
+		bc.logger.Debug("failed to get blob sidecars from historical plugin", "err", err)
+		return nil
+	}
+	return sidecars
+}
+
 // GetTransaction gets a transaction by hash. It also returns the block hash of the
 // block that the transaction was included in, the block number, and the index of the
 // transaction in the block. It only retrieves transactions that are included in the chain
@@ -224,7 +299,12 @@ func (bc *blockchain) GetTransactionLookup(
 	hash common.Hash,
 ) *types.TxLookupEntry {
 	// check the cache
+	earliest := bc.syncEarliestBlock()
 	if txLookupEntry, ok := bc.txLookupCache.Get(hash); ok {
+		if isPruned(txLookupEntry.BlockNum, earliest) {
+			bc.txLookupCache.Remove(hash)
+			return nil
+		}
 		return txLookupEntry
 	}
 
@@ -248,7 +328,17 @@ func (bc *blockchain) GetTransactionLookup(
 
 // GetHeaderByNumber retrieves a header from the blockchain.
 func (bc *blockchain) GetHeaderByNumber(number uint64) *ethtypes.Header {
-	header, _ := bc.bp.GetHeaderByNumber(number)
+	header, err := bc.bp.GetHeaderByNumber(number)
+	if err != nil && bc.hp != nil {
+		// the block plugin cannot read headers at heights whose host state has been pruned,
+		// so fall back to the historical plugin.
+		var block *ethtypes.Block
+		block, err = bc.hp.GetBlockByNumber(number)
+		if err != nil {
+			return nil
+		}
+		header = block.Header()
+	}
 	return header
 }
 
@@ -269,14 +359,15 @@ func (bc *blockchain) GetHeaderByHash(hash common.Hash) *ethtypes.Header {
 	return header
 }
 
-// GetTd retrieves a block's total difficulty in the canonical chain from the
-// database by hash and number, caching it if found.
+// GetTd retrieves a block's total difficulty in the canonical chain by hash and number. As the
+// difficulty of a block is meaningless after the merge, the total difficulty is defined as the
+// block number plus one, which is deterministic and monotonic.
 func (bc *blockchain) GetTd(hash common.Hash, number uint64) *big.Int {
 	block := bc.GetBlock(hash, number)
 	if block == nil {
 		return nil
 	}
-	return block.Difficulty()
+	return new(big.Int).SetUint64(block.NumberU64() + 1)
 }
 
 // HasBlock returns true if the blockchain contains a block with the given
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package core
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/berachain/polaris/eth/core/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Total Difficulty", func() {
+	var bc *blockchain
+
+	BeforeEach(func() {
+		bc = &blockchain{
+			blockNumCache:  lru.NewCache[uint64, *ethtypes.Block](defaultCacheSize),
+			blockHashCache: lru.NewCache[common.Hash, *ethtypes.Block](defaultCacheSize),
+			logger:         log.Root(),
+		}
+	})
+
+	It("should be the block number plus one", func() {
+		for i := int64(0); i < 3; i++ {
+			block := ethtypes.NewBlockWithHeader(&ethtypes.Header{
+				Number:     big.NewInt(i),
+				Difficulty: big.NewInt(1),
+			})
+			bc.blockHashCache.Add(block.Hash(), block)
+			Expect(bc.GetTd(block.Hash(), block.NumberU64())).To(Equal(big.NewInt(i + 1)))
+		}
+	})
+
+	It("should be nil for unknown blocks", func() {
+		Expect(bc.GetTd(common.Hash{0x01}, 1)).To(BeNil())
+	})
+})
+
+// prunedHistory is a historical plugin whose blocks before earliest have been pruned.
+type prunedHistory struct {
+	HistoricalPlugin
+	earliest uint64
+	blocks   map[common.Hash]*ethtypes.Block
+}
+
+func (h *prunedHistory) EarliestBlockNumber() uint64 { return h.earliest }
+
+func (h *prunedHistory) GetBlockByHash(hash common.Hash) (*ethtypes.Block, error) {
+	block, ok := h.blocks[hash]
+	if !ok {
+		return nil, ErrBlockNotFound
+	}
+	if block.NumberU64() < h.earliest {
+		return nil, fmt.Errorf("%w: block %d", ErrHistoryPruned, block.NumberU64())
+	}
+	return block, nil
+}
+
+func (h *prunedHistory) GetBlockByNumber(uint64) (*ethtypes.Block, error) {
+	return nil, ErrBlockNotFound
+}
+
+func (h *prunedHistory) GetReceiptsByHash(common.Hash) (ethtypes.Receipts, error) {
+	return nil, ErrBlockNotFound
+}
+
+func (h *prunedHistory) GetTransactionByHash(common.Hash) (*types.TxLookupEntry, error) {
+	return nil, ErrTxNotFound
+}
+
+var _ = Describe("Pruned history", func() {
+	var (
+		bc    *blockchain
+		hp    *prunedHistory
+		block *ethtypes.Block
+		tx    *ethtypes.Transaction
+	)
+
+	BeforeEach(func() {
+		block = ethtypes.NewBlockWithHeader(&ethtypes.Header{Number: big.NewInt(3)})
+		tx = ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 1})
+		hp = &prunedHistory{blocks: map[common.Hash]*ethtypes.Block{block.Hash(): block}}
+		bc = &blockchain{
+			hp:             hp,
+			receiptsCache:  lru.NewCache[common.Hash, ethtypes.Receipts](defaultCacheSize),
+			blockNumCache:  lru.NewCache[uint64, *ethtypes.Block](defaultCacheSize),
+			blockHashCache: lru.NewCache[common.Hash, *ethtypes.Block](defaultCacheSize),
+			txLookupCache:  lru.NewCache[common.Hash, *types.TxLookupEntry](defaultCacheSize),
+			logger:         log.Root(),
+		}
+		bc.blockNumCache.Add(3, block)
+		bc.blockHashCache.Add(block.Hash(), block)
+		bc.receiptsCache.Add(block.Hash(), ethtypes.Receipts{})
+		bc.txLookupCache.Add(tx.Hash(), &types.TxLookupEntry{Tx: tx, BlockNum: 3})
+	})
+
+	It("should serve cached blocks and txs until they are pruned", func() {
+		Expect(bc.GetBlockByNumber(3)).To(Equal(block))
+		Expect(bc.GetBlockByHash(block.Hash())).To(Equal(block))
+		Expect(bc.GetTransactionLookup(tx.Hash())).ToNot(BeNil())
+		Expect(bc.HistoryPruned(block.Hash())).To(BeFalse())
+
+		hp.earliest = 4
+		Expect(bc.GetBlockByNumber(3)).To(BeNil())
+		Expect(bc.GetBlockByHash(block.Hash())).To(BeNil())
+		Expect(bc.GetTransactionLookup(tx.Hash())).To(BeNil())
+		Expect(bc.GetReceiptsByHash(block.Hash())).To(BeNil())
+		Expect(bc.HistoryPruned(block.Hash())).To(BeTrue())
+		Expect(bc.HistoryPruned(common.Hash{0x1})).To(BeFalse())
+	})
+
+	It("should purge the caches when blocks are pruned", func() {
+		hp.earliest = 2
+		Expect(bc.GetTransactionLookup(common.Hash{0x1})).To(BeNil())
+		Expect(bc.blockNumCache.Len()).To(BeZero())
+		Expect(bc.blockHashCache.Len()).To(BeZero())
+		Expect(bc.receiptsCache.Len()).To(BeZero())
+		Expect(bc.txLookupCache.Len()).To(BeZero())
+
+		// Blocks that are still available are read again from the historical plugin.
+		Expect(bc.GetBlockByHash(block.Hash())).To(Equal(block))
+	})
+})
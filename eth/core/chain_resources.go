@@ -62,7 +62,12 @@ type ChainResources interface {
 }
 
 // StateAt returns a statedb configured to read what the state of the blockchain is/was at a given.
-func (bc *blockchain) StateAt(common.Hash) (state.StateDB, error) {
+// Since Polaris does not maintain state roots, only the root of the current head is supported.
+func (bc *blockchain) StateAt(root common.Hash) (state.StateDB, error) {
+	if head := bc.CurrentBlock(); head != nil && head.Root == root {
+		// Beginning of Block Number X == the same as State Root of Block Number X-1.
+		return bc.StateAtBlockNumber(head.Number.Uint64() + 1)
+	}
 	return nil, errors.New("StateAt is not implemented in polaris due state root")
 }
 
@@ -132,13 +137,10 @@ func (bc *blockchain) StateAtBlock(
 	// Check if the requested state is available in the live chain.
 	statedb, err := bc.StateAtBlockNumber(block.Number().Uint64())
 	if err != nil {
-		// If there is an error, it means the state is not available.
-		// TODO: Historic state is not supported in path-based scheme.
-		// Fully archive node in pbss will be implemented by relying
-		// on state history, but needs more work on top.
-		return nil, nil, errors.Join(
-			err, errors.New("historical state not available in path scheme yet"),
-		)
+		// If there is an error, it means the state is not available. Historical state is
+		// served from the host chain's versioned stores, so it is only retained for every
+		// height when the node runs in archive mode.
+		return nil, nil, fmt.Errorf("state at block %d: %w", block.NumberU64(), err)
 	}
 
 	// If there is no error, return the state, a no-op function, and no error.
@@ -201,5 +203,5 @@ func (bc *blockchain) GetVMConfig() *vm.Config {
 
 // Config returns the Ethereum chain config from the host chain.
 func (bc *blockchain) Config() *params.ChainConfig {
-	return bc.config
+	return bc.config.Load()
 }
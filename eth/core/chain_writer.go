@@ -32,6 +32,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 // ChainWriter defines methods that are used to perform state and block transitions.
@@ -40,7 +41,9 @@ type ChainWriter interface {
 	WriteGenesisBlock(block *ethtypes.Block) error
 	InsertBlock(block *ethtypes.Block) ([]*ethtypes.Receipt, error)
 	InsertBlockAndSetHead(block *ethtypes.Block) error
+	WriteBlobSidecars(block *ethtypes.Block, sidecars []*ethtypes.BlobTxSidecar) error
 	SetFinalizedBlock() error
+	SetConfig(config *params.ChainConfig)
 	WriteBlockAndSetHead(
 		block *ethtypes.Block, receipts []*ethtypes.Receipt, logs []*ethtypes.Log,
 		state state.StateDB, emitHeadEvent bool,
@@ -55,6 +58,9 @@ func (bc *blockchain) InsertBlock(block *ethtypes.Block) ([]*ethtypes.Receipt, e
 
 	// Call the private method to insert the block and setting it as the head.
 	receipts, _, err := bc.insertBlock(block, state)
+	if err != nil {
+		bc.reportBadBlock(block, err)
+	}
 	// Return any error that might have occurred.
 	return receipts, err
 }
@@ -98,6 +104,7 @@ func (bc *blockchain) InsertBlockAndSetHead(block *ethtypes.Block) error {
 
 	receipts, logs, err := bc.insertBlock(block, state)
 	if err != nil {
+		bc.reportBadBlock(block, err)
 		return err
 	}
 	// We can just immediately finalize the block. It's okay in this context.
@@ -184,15 +191,15 @@ func (bc *blockchain) writeBlockWithState(
 		return err
 	}
 
-	// Irrelevant of the canonical status, write the block itself to the database.
-	// TODO THIS NEEDS TO WRITE TO EXTERNAL DB.
+	// Irrelevant of the canonical status, write the block itself to the database. The historical
+	// plugin decides whether historical data is kept in the host chain's store or off-chain.
 	if err = bc.writeHistoricalData(block, receipts); err != nil {
 		return err
 	}
 
 	// Commit all cached state changes into underlying memory database.
 	// In Polaris this is a no-op.
-	_, err = state.Commit(block.NumberU64(), bc.config.IsEIP158(block.Number()))
+	_, err = state.Commit(block.NumberU64(), bc.Config().IsEIP158(block.Number()))
 	if err != nil {
 		return err
 	}
@@ -231,6 +238,21 @@ func (bc *blockchain) writeHistoricalData(
 	return nil
 }
 
+// WriteBlobSidecars stores the blob sidecars of the blob transactions included in the given
+// block, if the historical plugin is supported by the host chain.
+func (bc *blockchain) WriteBlobSidecars(
+	block *ethtypes.Block, sidecars []*ethtypes.BlobTxSidecar,
+) error {
+	if bc.hp == nil {
+		return nil
+	}
+	if err := bc.hp.StoreBlobSidecars(block.NumberU64(), sidecars); err != nil {
+		bc.logger.Error("failed to store blob sidecars", "err", err)
+		return err
+	}
+	return nil
+}
+
 // For clarity reasons, the host chain makes a separate call to finalize the block. Only called
 // once it is known the current block is the finalized block.
 func (bc *blockchain) SetFinalizedBlock() error {
@@ -239,3 +261,12 @@ func (bc *blockchain) SetFinalizedBlock() error {
 	}
 	return nil
 }
+
+// SetConfig replaces the chain config of the blockchain. The config is swapped as a whole, so
+// readers never see a partially updated config. It must not be called concurrently with
+// InsertBlock, as the block processor and validator are rebuilt for the new config.
+func (bc *blockchain) SetConfig(config *params.ChainConfig) {
+	bc.config.Store(config)
+	bc.processor = core.NewStateProcessor(config, bc, bc.engine)
+	bc.validator = core.NewBlockValidator(config, bc, bc.engine)
+}
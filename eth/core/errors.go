@@ -31,4 +31,6 @@ var (
 	ErrHeaderNotFound   = errors.New("header not found")
 	ErrReceiptsNotFound = errors.New("receipts not found")
 	ErrTxNotFound       = errors.New("transaction not found")
+	ErrHistoryPruned    = errors.New("historical data pruned")
+	ErrStatePruned      = errors.New("historical state pruned, run an archive node")
 )
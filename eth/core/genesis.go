@@ -30,6 +30,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 type (
@@ -38,6 +39,14 @@ type (
 	GenesisAccount = core.GenesisAccount
 )
 
+// beaconRootsCode is the code of the EIP-4788 beacon roots contract, which stores the parent
+// beacon block root of every block post Cancun.
+var beaconRootsCode = common.FromHex(
+	"3373fffffffffffffffffffffffffffffffffffffffe14604d57602036146024575f5ffd5b5f35801560495762" +
+		"001fff810690815414603c575f5ffd5b62001fff01545f5260205ff35b5f5ffd5b62001fff42064281555f35" +
+		"9062001fff015500",
+)
+
 // DefaultGenesis is the default genesis block used by Polaris.
 var DefaultGenesis = &core.Genesis{
 	// Genesis Block
@@ -56,6 +65,12 @@ var DefaultGenesis = &core.Genesis{
 		common.HexToAddress("0x20f33CE90A13a4b5E7697E3544c3083B8F8A51D4"): {
 			Balance: big.NewInt(0).Mul(big.NewInt(5e18), big.NewInt(100)), //nolint:gomnd // its okay.
 		},
+		// EIP-4788 beacon roots contract.
+		params.BeaconRootsStorageAddress: {
+			Nonce:   1,
+			Balance: big.NewInt(0),
+			Code:    beaconRootsCode,
+		},
 	},
 
 	// These fields are used for consensus tests. Please don't use them
@@ -102,6 +102,9 @@ type (
 	HistoricalPlugin interface {
 		// HistoricalPlugin implements `libtypes.Preparable`.
 		libtypes.Preparable
+		// EarliestBlockNumber returns the number of the earliest block whose historical data has
+		// not been pruned.
+		EarliestBlockNumber() uint64
 		// GetBlockByNumber returns the block at the given block number.
 		GetBlockByNumber(uint64) (*ethtypes.Block, error)
 		// GetBlockByHash returns the block at the given block hash.
@@ -111,12 +114,18 @@ type (
 		GetTransactionByHash(common.Hash) (*types.TxLookupEntry, error)
 		// GetReceiptByHash returns the receipts at the given block hash.
 		GetReceiptsByHash(common.Hash) (ethtypes.Receipts, error)
+		// GetBlobSidecarsByHash returns the blob sidecars, in transaction order, of the blob
+		// transactions included in the block with the given block hash.
+		GetBlobSidecarsByHash(common.Hash) ([]*ethtypes.BlobTxSidecar, error)
 		// StoreBlock stores the given block.
 		StoreBlock(*ethtypes.Block) error
 		// StoreReceipts stores the receipts for the given block hash.
 		StoreReceipts(common.Hash, ethtypes.Receipts) error
 		// StoreTransactions stores the transactions for the given block hash.
 		StoreTransactions(uint64, common.Hash, ethtypes.Transactions) error
+		// StoreBlobSidecars stores the blob sidecars for the given block number and prunes the
+		// sidecars that fall outside of the retention window.
+		StoreBlobSidecars(uint64, []*ethtypes.BlobTxSidecar) error
 	}
 
 	// PrecompilePlugin defines the methods that the chain running Polaris EVM should implement
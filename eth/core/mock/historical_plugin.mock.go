@@ -22,6 +22,12 @@ var _ core.HistoricalPlugin = &HistoricalPluginMock{}
 //
 //		// make and configure a mocked core.HistoricalPlugin
 //		mockedHistoricalPlugin := &HistoricalPluginMock{
+//			EarliestBlockNumberFunc: func() uint64 {
+//				panic("mock out the EarliestBlockNumber method")
+//			},
+//			GetBlobSidecarsByHashFunc: func(hash common.Hash) ([]*ethtypes.BlobTxSidecar, error) {
+//				panic("mock out the GetBlobSidecarsByHash method")
+//			},
 //			GetBlockByHashFunc: func(hash common.Hash) (*ethtypes.Block, error) {
 //				panic("mock out the GetBlockByHash method")
 //			},
@@ -37,6 +43,9 @@ var _ core.HistoricalPlugin = &HistoricalPluginMock{}
 //			PrepareFunc: func(contextMoqParam context.Context)  {
 //				panic("mock out the Prepare method")
 //			},
+//			StoreBlobSidecarsFunc: func(v uint64, blobTxSidecars []*ethtypes.BlobTxSidecar) error {
+//				panic("mock out the StoreBlobSidecars method")
+//			},
 //			StoreBlockFunc: func(block *ethtypes.Block) error {
 //				panic("mock out the StoreBlock method")
 //			},
@@ -53,6 +62,12 @@ var _ core.HistoricalPlugin = &HistoricalPluginMock{}
 //
 //	}
 type HistoricalPluginMock struct {
+	// EarliestBlockNumberFunc mocks the EarliestBlockNumber method.
+	EarliestBlockNumberFunc func() uint64
+
+	// GetBlobSidecarsByHashFunc mocks the GetBlobSidecarsByHash method.
+	GetBlobSidecarsByHashFunc func(hash common.Hash) ([]*ethtypes.BlobTxSidecar, error)
+
 	// GetBlockByHashFunc mocks the GetBlockByHash method.
 	GetBlockByHashFunc func(hash common.Hash) (*ethtypes.Block, error)
 
@@ -68,6 +83,9 @@ type HistoricalPluginMock struct {
 	// PrepareFunc mocks the Prepare method.
 	PrepareFunc func(contextMoqParam context.Context)
 
+	// StoreBlobSidecarsFunc mocks the StoreBlobSidecars method.
+	StoreBlobSidecarsFunc func(v uint64, blobTxSidecars []*ethtypes.BlobTxSidecar) error
+
 	// StoreBlockFunc mocks the StoreBlock method.
 	StoreBlockFunc func(block *ethtypes.Block) error
 
@@ -79,6 +97,14 @@ type HistoricalPluginMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// EarliestBlockNumber holds details about calls to the EarliestBlockNumber method.
+		EarliestBlockNumber []struct {
+		}
+		// GetBlobSidecarsByHash holds details about calls to the GetBlobSidecarsByHash method.
+		GetBlobSidecarsByHash []struct {
+			// Hash is the hash argument value.
+			Hash common.Hash
+		}
 		// GetBlockByHash holds details about calls to the GetBlockByHash method.
 		GetBlockByHash []struct {
 			// Hash is the hash argument value.
@@ -104,6 +130,13 @@ type HistoricalPluginMock struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
 		}
+		// StoreBlobSidecars holds details about calls to the StoreBlobSidecars method.
+		StoreBlobSidecars []struct {
+			// V is the v argument value.
+			V uint64
+			// BlobTxSidecars is the blobTxSidecars argument value.
+			BlobTxSidecars []*ethtypes.BlobTxSidecar
+		}
 		// StoreBlock holds details about calls to the StoreBlock method.
 		StoreBlock []struct {
 			// Block is the block argument value.
@@ -126,14 +159,76 @@ type HistoricalPluginMock struct {
 			Transactions ethtypes.Transactions
 		}
 	}
-	lockGetBlockByHash       sync.RWMutex
-	lockGetBlockByNumber     sync.RWMutex
-	lockGetReceiptsByHash    sync.RWMutex
-	lockGetTransactionByHash sync.RWMutex
-	lockPrepare              sync.RWMutex
-	lockStoreBlock           sync.RWMutex
-	lockStoreReceipts        sync.RWMutex
-	lockStoreTransactions    sync.RWMutex
+	lockEarliestBlockNumber   sync.RWMutex
+	lockGetBlobSidecarsByHash sync.RWMutex
+	lockGetBlockByHash        sync.RWMutex
+	lockGetBlockByNumber      sync.RWMutex
+	lockGetReceiptsByHash     sync.RWMutex
+	lockGetTransactionByHash  sync.RWMutex
+	lockPrepare               sync.RWMutex
+	lockStoreBlobSidecars     sync.RWMutex
+	lockStoreBlock            sync.RWMutex
+	lockStoreReceipts         sync.RWMutex
+	lockStoreTransactions     sync.RWMutex
+}
+
+// EarliestBlockNumber calls EarliestBlockNumberFunc.
+func (mock *HistoricalPluginMock) EarliestBlockNumber() uint64 {
+	if mock.EarliestBlockNumberFunc == nil {
+		panic("HistoricalPluginMock.EarliestBlockNumberFunc: method is nil but HistoricalPlugin.EarliestBlockNumber was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockEarliestBlockNumber.Lock()
+	mock.calls.EarliestBlockNumber = append(mock.calls.EarliestBlockNumber, callInfo)
+	mock.lockEarliestBlockNumber.Unlock()
+	return mock.EarliestBlockNumberFunc()
+}
+
+// EarliestBlockNumberCalls gets all the calls that were made to EarliestBlockNumber.
+// Check the length with:
+//
+//	len(mockedHistoricalPlugin.EarliestBlockNumberCalls())
+func (mock *HistoricalPluginMock) EarliestBlockNumberCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockEarliestBlockNumber.RLock()
+	calls = mock.calls.EarliestBlockNumber
+	mock.lockEarliestBlockNumber.RUnlock()
+	return calls
+}
+
+// GetBlobSidecarsByHash calls GetBlobSidecarsByHashFunc.
+func (mock *HistoricalPluginMock) GetBlobSidecarsByHash(hash common.Hash) ([]*ethtypes.BlobTxSidecar, error) {
+	if mock.GetBlobSidecarsByHashFunc == nil {
+		panic("HistoricalPluginMock.GetBlobSidecarsByHashFunc: method is nil but HistoricalPlugin.GetBlobSidecarsByHash was just called")
+	}
+	callInfo := struct {
+		Hash common.Hash
+	}{
+		Hash: hash,
+	}
+	mock.lockGetBlobSidecarsByHash.Lock()
+	mock.calls.GetBlobSidecarsByHash = append(mock.calls.GetBlobSidecarsByHash, callInfo)
+	mock.lockGetBlobSidecarsByHash.Unlock()
+	return mock.GetBlobSidecarsByHashFunc(hash)
+}
+
+// GetBlobSidecarsByHashCalls gets all the calls that were made to GetBlobSidecarsByHash.
+// Check the length with:
+//
+//	len(mockedHistoricalPlugin.GetBlobSidecarsByHashCalls())
+func (mock *HistoricalPluginMock) GetBlobSidecarsByHashCalls() []struct {
+	Hash common.Hash
+} {
+	var calls []struct {
+		Hash common.Hash
+	}
+	mock.lockGetBlobSidecarsByHash.RLock()
+	calls = mock.calls.GetBlobSidecarsByHash
+	mock.lockGetBlobSidecarsByHash.RUnlock()
+	return calls
 }
 
 // GetBlockByHash calls GetBlockByHashFunc.
@@ -296,6 +391,42 @@ func (mock *HistoricalPluginMock) PrepareCalls() []struct {
 	return calls
 }
 
+// StoreBlobSidecars calls StoreBlobSidecarsFunc.
+func (mock *HistoricalPluginMock) StoreBlobSidecars(v uint64, blobTxSidecars []*ethtypes.BlobTxSidecar) error {
+	if mock.StoreBlobSidecarsFunc == nil {
+		panic("HistoricalPluginMock.StoreBlobSidecarsFunc: method is nil but HistoricalPlugin.StoreBlobSidecars was just called")
+	}
+	callInfo := struct {
+		V              uint64
+		BlobTxSidecars []*ethtypes.BlobTxSidecar
+	}{
+		V:              v,
+		BlobTxSidecars: blobTxSidecars,
+	}
+	mock.lockStoreBlobSidecars.Lock()
+	mock.calls.StoreBlobSidecars = append(mock.calls.StoreBlobSidecars, callInfo)
+	mock.lockStoreBlobSidecars.Unlock()
+	return mock.StoreBlobSidecarsFunc(v, blobTxSidecars)
+}
+
+// StoreBlobSidecarsCalls gets all the calls that were made to StoreBlobSidecars.
+// Check the length with:
+//
+//	len(mockedHistoricalPlugin.StoreBlobSidecarsCalls())
+func (mock *HistoricalPluginMock) StoreBlobSidecarsCalls() []struct {
+	V              uint64
+	BlobTxSidecars []*ethtypes.BlobTxSidecar
+} {
+	var calls []struct {
+		V              uint64
+		BlobTxSidecars []*ethtypes.BlobTxSidecar
+	}
+	mock.lockStoreBlobSidecars.RLock()
+	calls = mock.calls.StoreBlobSidecars
+	mock.lockStoreBlobSidecars.RUnlock()
+	return calls
+}
+
 // StoreBlock calls StoreBlockFunc.
 func (mock *HistoricalPluginMock) StoreBlock(block *ethtypes.Block) error {
 	if mock.StoreBlockFunc == nil {
@@ -58,6 +58,9 @@ var _ core.StatePlugin = &StatePluginMock{}
 //			GetCodeHashFunc: func(address common.Address) common.Hash {
 //				panic("mock out the GetCodeHash method")
 //			},
+//			GetCodeSizeFunc: func(address common.Address) int {
+//				panic("mock out the GetCodeSize method")
+//			},
 //			GetCommittedStateFunc: func(address common.Address, hash common.Hash) common.Hash {
 //				panic("mock out the GetCommittedState method")
 //			},
@@ -152,6 +155,9 @@ type StatePluginMock struct {
 	// GetCodeHashFunc mocks the GetCodeHash method.
 	GetCodeHashFunc func(address common.Address) common.Hash
 
+	// GetCodeSizeFunc mocks the GetCodeSize method.
+	GetCodeSizeFunc func(address common.Address) int
+
 	// GetCommittedStateFunc mocks the GetCommittedState method.
 	GetCommittedStateFunc func(address common.Address, hash common.Hash) common.Hash
 
@@ -263,6 +269,11 @@ type StatePluginMock struct {
 			// Address is the address argument value.
 			Address common.Address
 		}
+		// GetCodeSize holds details about calls to the GetCodeSize method.
+		GetCodeSize []struct {
+			// Address is the address argument value.
+			Address common.Address
+		}
 		// GetCommittedState holds details about calls to the GetCommittedState method.
 		GetCommittedState []struct {
 			// Address is the address argument value.
@@ -371,6 +382,7 @@ type StatePluginMock struct {
 	lockGetBalance         sync.RWMutex
 	lockGetCode            sync.RWMutex
 	lockGetCodeHash        sync.RWMutex
+	lockGetCodeSize        sync.RWMutex
 	lockGetCommittedState  sync.RWMutex
 	lockGetContext         sync.RWMutex
 	lockGetNonce           sync.RWMutex
@@ -767,6 +779,38 @@ func (mock *StatePluginMock) GetCodeHashCalls() []struct {
 	return calls
 }
 
+// GetCodeSize calls GetCodeSizeFunc.
+func (mock *StatePluginMock) GetCodeSize(address common.Address) int {
+	if mock.GetCodeSizeFunc == nil {
+		panic("StatePluginMock.GetCodeSizeFunc: method is nil but StatePlugin.GetCodeSize was just called")
+	}
+	callInfo := struct {
+		Address common.Address
+	}{
+		Address: address,
+	}
+	mock.lockGetCodeSize.Lock()
+	mock.calls.GetCodeSize = append(mock.calls.GetCodeSize, callInfo)
+	mock.lockGetCodeSize.Unlock()
+	return mock.GetCodeSizeFunc(address)
+}
+
+// GetCodeSizeCalls gets all the calls that were made to GetCodeSize.
+// Check the length with:
+//
+//	len(mockedStatePlugin.GetCodeSizeCalls())
+func (mock *StatePluginMock) GetCodeSizeCalls() []struct {
+	Address common.Address
+} {
+	var calls []struct {
+		Address common.Address
+	}
+	mock.lockGetCodeSize.RLock()
+	calls = mock.calls.GetCodeSize
+	mock.lockGetCodeSize.RUnlock()
+	return calls
+}
+
 // GetCommittedState calls GetCommittedStateFunc.
 func (mock *StatePluginMock) GetCommittedState(address common.Address, hash common.Hash) common.Hash {
 	if mock.GetCommittedStateFunc == nil {
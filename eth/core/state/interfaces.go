@@ -75,6 +75,8 @@ type Plugin interface {
 	GetCodeHash(common.Address) common.Hash
 	// GetCode returns the code associated with a given account.
 	GetCode(common.Address) []byte
+	// GetCodeSize returns the size of the code associated with a given account.
+	GetCodeSize(common.Address) int
 	// SetCode sets the code associated with a given account.
 	SetCode(common.Address, []byte)
 
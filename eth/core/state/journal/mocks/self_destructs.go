@@ -194,6 +194,39 @@ func (_c *SelfDestructs_HasSelfDestructed_Call) RunAndReturn(run func(common.Add
 	return _c
 }
 
+// MarkCreated provides a mock function with given fields: _a0
+func (_m *SelfDestructs) MarkCreated(_a0 common.Address) {
+	_m.Called(_a0)
+}
+
+// SelfDestructs_MarkCreated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkCreated'
+type SelfDestructs_MarkCreated_Call struct {
+	*mock.Call
+}
+
+// MarkCreated is a helper method to define mock.On call
+//   - _a0 common.Address
+func (_e *SelfDestructs_Expecter) MarkCreated(_a0 interface{}) *SelfDestructs_MarkCreated_Call {
+	return &SelfDestructs_MarkCreated_Call{Call: _e.mock.On("MarkCreated", _a0)}
+}
+
+func (_c *SelfDestructs_MarkCreated_Call) Run(run func(_a0 common.Address)) *SelfDestructs_MarkCreated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(common.Address))
+	})
+	return _c
+}
+
+func (_c *SelfDestructs_MarkCreated_Call) Return() *SelfDestructs_MarkCreated_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SelfDestructs_MarkCreated_Call) RunAndReturn(run func(common.Address)) *SelfDestructs_MarkCreated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RegistryKey provides a mock function with given fields:
 func (_m *SelfDestructs) RegistryKey() string {
 	ret := _m.Called()
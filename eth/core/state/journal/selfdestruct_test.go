@@ -78,6 +78,29 @@ var _ = Describe("SelfDestructs", func() {
 		Expect(s.HasSelfDestructed(a1)).To(BeFalse())
 	})
 
+	It("should only self destruct accounts created in the tx post eip-6780", func() {
+		s.Snapshot()
+		s.Selfdestruct6780(a1)
+		Expect(s.HasSelfDestructed(a1)).To(BeFalse())
+
+		s.MarkCreated(a2)
+		s.Selfdestruct6780(a2)
+		Expect(s.HasSelfDestructed(a2)).To(BeTrue())
+		Expect(s.GetSelfDestructs()).To(Equal([]common.Address{a2}))
+
+		snap := s.Snapshot()
+		s.MarkCreated(a3)
+		s.RevertToSnapshot(snap)
+		s.Snapshot()
+		s.Selfdestruct6780(a3)
+		Expect(s.HasSelfDestructed(a3)).To(BeFalse())
+
+		s.Finalize()
+		s.Snapshot()
+		s.Selfdestruct6780(a2)
+		Expect(s.HasSelfDestructed(a2)).To(BeFalse())
+	})
+
 	It("should clone correctly", func() {
 		s.Snapshot()
 		s.SelfDestruct(a1)
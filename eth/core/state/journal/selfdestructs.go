@@ -55,8 +55,11 @@ type SelfDestructs interface {
 	libtypes.Cloneable[SelfDestructs]
 	// SelfDestruct marks the given address as self destructed .
 	SelfDestruct(common.Address)
-	// Selfdestruct6780 marks the given address as self destructed post eip-6780
+	// Selfdestruct6780 marks the given address as self destructed post eip-6780, which is only
+	// allowed if the account was created in the current transaction.
 	Selfdestruct6780(common.Address)
+	// MarkCreated marks the given address as created in the current transaction.
+	MarkCreated(common.Address)
 	// HasSelfDestructed returns whether the address is self destructed .
 	HasSelfDestructed(common.Address) bool
 	// GetSelfDestructs returns all self destructed addresses from the tx.
@@ -70,15 +73,27 @@ type SelfDestructs interface {
 type selfDestructs struct {
 	// journal of suicide address per call, very rare to suicide so we alloc only 1 address
 	baseJournal[*common.Address]
-	ssp selfDestructStatePlugin
+	// created is a journal of the addresses created in the current transaction, which are the
+	// only accounts that can be self destructed post eip-6780.
+	created baseJournal[common.Address]
+	// revisions stores the sizes of both journals for every snapshot.
+	revisions []selfDestructsRevision
+	ssp       selfDestructStatePlugin
 	// lastSnapshot ensures that only 1 address is being self destructed per snapshot
 	lastSnapshot int
 }
 
+// selfDestructsRevision is the size of the self destructed and created journals at a snapshot.
+type selfDestructsRevision struct {
+	selfDestructed int
+	created        int
+}
+
 // NewSelfDestructs returns a new selfDestructs journal.
 func NewSelfDestructs(ssp selfDestructStatePlugin) SelfDestructs {
 	return &selfDestructs{
 		baseJournal:  newBaseJournal[*common.Address](initCapacity),
+		created:      newBaseJournal[common.Address](initCapacity),
 		ssp:          ssp,
 		lastSnapshot: -1,
 	}
@@ -105,6 +120,25 @@ func (s *selfDestructs) SelfDestruct(addr common.Address) {
 		return
 	}
 
+	s.selfDestruct(addr)
+}
+
+// Selfdestruct6780 implements the PolarStateDB interface by marking the given address as self
+// destructed only if it was created in the current transaction (EIP-6780). Otherwise, SELFDESTRUCT
+// only transfers the balance of the account, which is handled by the EVM.
+func (s *selfDestructs) Selfdestruct6780(addr common.Address) {
+	// ensure only one suicide per snapshot call
+	if s.Size() > s.lastSnapshot || !s.isCreated(addr) {
+		return
+	}
+
+	// The code of an account being created is only set after its constructor returns, so the
+	// code hash is not checked here.
+	s.selfDestruct(addr)
+}
+
+// selfDestruct clears the balance of the given address and adds it to the journal.
+func (s *selfDestructs) selfDestruct(addr common.Address) {
 	// Reduce it's balance to 0.
 	s.ssp.SubBalance(addr, s.ssp.GetBalance(addr))
 
@@ -112,8 +146,22 @@ func (s *selfDestructs) SelfDestruct(addr common.Address) {
 	s.Push(&addr)
 }
 
-func (s *selfDestructs) Selfdestruct6780(_ common.Address) {
-	// TODO: IMPLEMENT EIP-6780
+// MarkCreated implements the PolarStateDB interface by marking the given address as created in
+// the current transaction.
+func (s *selfDestructs) MarkCreated(addr common.Address) {
+	if !s.isCreated(addr) {
+		s.created.Push(addr)
+	}
+}
+
+// isCreated returns whether the given address was created in the current transaction.
+func (s *selfDestructs) isCreated(addr common.Address) bool {
+	for i := s.created.Size() - 1; i >= 0; i-- {
+		if s.created.PeekAt(i) == addr {
+			return true
+		}
+	}
+	return false
 }
 
 // HasSelfDestructed implements the PolarStateDB interface by returning if the contract was
@@ -136,9 +184,22 @@ func (s *selfDestructs) GetSelfDestructs() []common.Address {
 	return suicidalAddrs
 }
 
+// Snapshot implements `libtypes.Snapshottable`.
 func (s *selfDestructs) Snapshot() int {
 	s.lastSnapshot = s.Size()
-	return s.baseJournal.Snapshot()
+	s.revisions = append(s.revisions, selfDestructsRevision{
+		selfDestructed: s.baseJournal.Snapshot(),
+		created:        s.created.Snapshot(),
+	})
+	return len(s.revisions) - 1
+}
+
+// RevertToSnapshot implements `libtypes.Snapshottable`.
+func (s *selfDestructs) RevertToSnapshot(id int) {
+	revision := s.revisions[id]
+	s.baseJournal.RevertToSnapshot(revision.selfDestructed)
+	s.created.RevertToSnapshot(revision.created)
+	s.revisions = s.revisions[:id]
 }
 
 // Finalize implements libtypes.Controllable.
@@ -150,16 +211,21 @@ func (s *selfDestructs) Finalize() {
 func (s *selfDestructs) Clone() SelfDestructs {
 	clone := &selfDestructs{
 		baseJournal:  newBaseJournal[*common.Address](s.Capacity()),
+		created:      newBaseJournal[common.Address](s.created.Capacity()),
+		revisions:    append([]selfDestructsRevision(nil), s.revisions...),
 		ssp:          s.ssp,
 		lastSnapshot: s.lastSnapshot,
 	}
 
-	// copy every address from the journal
+	// copy every address from the journals
 	for i := 0; i < s.Size(); i++ {
 		cpy := new(common.Address)
 		*cpy = *s.PeekAt(i)
 		clone.Push(cpy)
 	}
+	for i := 0; i < s.created.Size(); i++ {
+		clone.created.Push(s.created.PeekAt(i))
+	}
 
 	return clone
 }
@@ -101,6 +101,12 @@ func NewEmptyStatePlugin() *PluginMock {
 			}
 			return Accounts[address].Code
 		},
+		GetCodeSizeFunc: func(address common.Address) int {
+			if _, ok := Accounts[address]; !ok {
+				return 0
+			}
+			return len(Accounts[address].Code)
+		},
 		GetCodeHashFunc: func(address common.Address) common.Hash {
 			if _, ok := Accounts[address]; !ok {
 				return common.Hash{}
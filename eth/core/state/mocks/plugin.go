@@ -533,6 +533,52 @@ func (_c *Plugin_GetCodeHash_Call) RunAndReturn(run func(common.Address) common.
 	return _c
 }
 
+// GetCodeSize provides a mock function with given fields: _a0
+func (_m *Plugin) GetCodeSize(_a0 common.Address) int {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCodeSize")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(common.Address) int); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Plugin_GetCodeSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCodeSize'
+type Plugin_GetCodeSize_Call struct {
+	*mock.Call
+}
+
+// GetCodeSize is a helper method to define mock.On call
+//   - _a0 common.Address
+func (_e *Plugin_Expecter) GetCodeSize(_a0 interface{}) *Plugin_GetCodeSize_Call {
+	return &Plugin_GetCodeSize_Call{Call: _e.mock.On("GetCodeSize", _a0)}
+}
+
+func (_c *Plugin_GetCodeSize_Call) Run(run func(_a0 common.Address)) *Plugin_GetCodeSize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(common.Address))
+	})
+	return _c
+}
+
+func (_c *Plugin_GetCodeSize_Call) Return(_a0 int) *Plugin_GetCodeSize_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Plugin_GetCodeSize_Call) RunAndReturn(run func(common.Address) int) *Plugin_GetCodeSize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCommittedState provides a mock function with given fields: _a0, _a1
 func (_m *Plugin) GetCommittedState(_a0 common.Address, _a1 common.Hash) common.Hash {
 	ret := _m.Called(_a0, _a1)
@@ -124,6 +124,17 @@ func (sdb *stateDB) GetPrecompileManager() any {
 	return sdb.pp
 }
 
+// =============================================================================
+// Account
+// =============================================================================
+
+// CreateAccount implements vm.PolarStateDB by creating the account in the plugin and marking it
+// as created in the current transaction, as required by EIP-6780.
+func (sdb *stateDB) CreateAccount(addr common.Address) {
+	sdb.Plugin.CreateAccount(addr)
+	sdb.SelfDestructs.MarkCreated(addr)
+}
+
 // =============================================================================
 // Snapshot
 // =============================================================================
@@ -221,8 +232,8 @@ func (sdb *stateDB) Preimages() map[common.Hash][]byte {
 // Code
 // =============================================================================
 
-// GetCodeSize implements the vm.PolarStateDB interface by returning the size of the
-// code associated with the given account.
+// GetCode implements the vm.PolarStateDB interface by returning the code associated with
+// the given account.
 func (sdb *stateDB) GetCode(addr common.Address) []byte {
 	// We return a single byte for client compatibility w/precompiles.
 	if sdb.pp != nil {
@@ -236,7 +247,13 @@ func (sdb *stateDB) GetCode(addr common.Address) []byte {
 // GetCodeSize implements the vm.PolarStateDB interface by returning the size of the
 // code associated with the given account.
 func (sdb *stateDB) GetCodeSize(addr common.Address) int {
-	return len(sdb.GetCode(addr))
+	// We return a single byte for client compatibility w/precompiles.
+	if sdb.pp != nil {
+		if _, ok := sdb.pp.Get(addr, sdb.rules); ok {
+			return 1
+		}
+	}
+	return sdb.Plugin.GetCodeSize(addr)
 }
 
 // =============================================================================
@@ -79,6 +79,18 @@ var _ = Describe("StateDB", func() {
 		Expect(sdb.HasSelfDestructed(bob)).To(BeTrue())
 	})
 
+	It("Should only SelfDestruct accounts created in the tx post eip-6780", func() {
+		sdb.Snapshot()
+		sdb.Selfdestruct6780(alice)
+		Expect(sdb.HasSelfDestructed(alice)).To(BeFalse())
+
+		sdb.CreateAccount(bob)
+		sdb.AddBalance(bob, big.NewInt(10))
+		sdb.Selfdestruct6780(bob)
+		Expect(sdb.GetBalance(bob).Uint64()).To(Equal(uint64(0)))
+		Expect(sdb.HasSelfDestructed(bob)).To(BeTrue())
+	})
+
 	It("should snapshot/revert", func() {
 		Expect(func() {
 			id := sdb.Snapshot()
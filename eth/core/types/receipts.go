@@ -39,8 +39,9 @@ func DeriveReceiptsFromBlock(
 ) (ethtypes.Receipts, error) {
 	// calculate the blobGasPrice according to the excess blob gas.
 	var blobGasPrice = new(big.Int)
-	if chainConfig.IsCancun(block.Number(), block.Time()) {
-		blobGasPrice = eip4844.CalcBlobFee(*block.ExcessBlobGas())
+	if excess := block.ExcessBlobGas(); excess != nil &&
+		chainConfig.IsCancun(block.Number(), block.Time()) {
+		blobGasPrice = eip4844.CalcBlobFee(*excess)
 	}
 
 	// Derive receipts from block.
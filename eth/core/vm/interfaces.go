@@ -37,6 +37,8 @@ type (
 		vm.StateDB
 		// GetContext returns the current context of the state plugin.
 		GetContext() context.Context
+		// TxIndex returns the index of the transaction that is currently being executed.
+		TxIndex() int
 	}
 
 	PrecompileEVM interface {
@@ -117,6 +117,9 @@ func NewEmptyStateDB() *PolarStateDBMock {
 		},
 		SubRefundFunc: func(v uint64) {
 
+		},
+		TxIndexFunc: func() int {
+			return 0
 		},
 		SelfDestructFunc: func(address common.Address) {
 		},
@@ -125,6 +125,9 @@ var _ vm.PolarStateDB = &PolarStateDBMock{}
 //			SubRefundFunc: func(v uint64)  {
 //				panic("mock out the SubRefund method")
 //			},
+//			TxIndexFunc: func() int {
+//				panic("mock out the TxIndex method")
+//			},
 //		}
 //
 //		// use mockedPolarStateDB in code that requires vm.PolarStateDB
@@ -234,6 +237,9 @@ type PolarStateDBMock struct {
 	// SubRefundFunc mocks the SubRefund method.
 	SubRefundFunc func(v uint64)
 
+	// TxIndexFunc mocks the TxIndex method.
+	TxIndexFunc func() int
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// AddAddressToAccessList holds details about calls to the AddAddressToAccessList method.
@@ -436,6 +442,9 @@ type PolarStateDBMock struct {
 			// V is the v argument value.
 			V uint64
 		}
+		// TxIndex holds details about calls to the TxIndex method.
+		TxIndex []struct {
+		}
 	}
 	lockAddAddressToAccessList sync.RWMutex
 	lockAddBalance             sync.RWMutex
@@ -471,6 +480,7 @@ type PolarStateDBMock struct {
 	lockSnapshot               sync.RWMutex
 	lockSubBalance             sync.RWMutex
 	lockSubRefund              sync.RWMutex
+	lockTxIndex                sync.RWMutex
 }
 
 // AddAddressToAccessList calls AddAddressToAccessListFunc.
@@ -1616,3 +1626,30 @@ func (mock *PolarStateDBMock) SubRefundCalls() []struct {
 	mock.lockSubRefund.RUnlock()
 	return calls
 }
+
+// TxIndex calls TxIndexFunc.
+func (mock *PolarStateDBMock) TxIndex() int {
+	if mock.TxIndexFunc == nil {
+		panic("PolarStateDBMock.TxIndexFunc: method is nil but PolarStateDB.TxIndex was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockTxIndex.Lock()
+	mock.calls.TxIndex = append(mock.calls.TxIndex, callInfo)
+	mock.lockTxIndex.Unlock()
+	return mock.TxIndexFunc()
+}
+
+// TxIndexCalls gets all the calls that were made to TxIndex.
+// Check the length with:
+//
+//	len(mockedPolarStateDB.TxIndexCalls())
+func (mock *PolarStateDBMock) TxIndexCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockTxIndex.RLock()
+	calls = mock.calls.TxIndex
+	mock.lockTxIndex.RUnlock()
+	return calls
+}
@@ -26,6 +26,7 @@ package eth
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/berachain/polaris/eth/consensus"
 	pcore "github.com/berachain/polaris/eth/core"
@@ -53,6 +54,7 @@ type (
 	// TxPool represents the `TxPool` that exists on the backend of the execution layer.
 	TxPool interface {
 		Add([]*ethtypes.Transaction, bool, bool) []error
+		Get(hash common.Hash) *ethtypes.Transaction
 		Stats() (int, int)
 		SubscribeTransactions(ch chan<- core.NewTxsEvent, reorgs bool) event.Subscription
 		Status(hash common.Hash) txpool.TxStatus
@@ -92,9 +94,29 @@ type (
 	// Config struct holds the configuration for Polaris and Node.
 	Config struct {
 		OptimisticExecution bool
+		ArchiveMode         bool
+		GethLogLevel        string
+		TxPolicies          TxPolicyConfig
 		Polar               polar.Config
 		Node                node.Config
 	}
+
+	// TxPolicyConfig holds the settings of the txpool admission policies, each of which is
+	// disabled when its settings are zero.
+	TxPolicyConfig struct {
+		// DenyList are the addresses that may not send or receive transactions.
+		DenyList []common.Address
+		// MaxCalldataSize is the maximum size of the calldata of a transaction in bytes.
+		MaxCalldataSize int
+		// RestrictContractCreation only allows the Deployers to create contracts.
+		RestrictContractCreation bool
+		// Deployers are the addresses allowed to create contracts.
+		Deployers []common.Address
+		// SenderRateLimit is the maximum number of transactions a sender may submit per
+		// SenderRateLimitWindow.
+		SenderRateLimit       int
+		SenderRateLimitWindow time.Duration
+	}
 )
 
 // New creates a new execution layer with the provided host chain.
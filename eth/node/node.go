@@ -24,10 +24,19 @@
 package node
 
 import (
+	"slices"
+	"sync"
+
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/node"
 )
 
+// polarisNamespace is the namespace of the Polaris JSON-RPC methods.
+const polarisNamespace = "polaris"
+
+// authModulesMu serializes the starts of the nodes, which swap in their auth modules.
+var authModulesMu sync.Mutex
+
 type (
 	// Lifecycle represents a lifecycle object.
 	Lifecycle = node.Lifecycle
@@ -35,6 +44,8 @@ type (
 	// GethExecutionNode is the entrypoint for the evm execution environment.
 	GethExecutionNode struct {
 		*node.Node
+		// authModules are the JSON-RPC modules served on the authenticated auth port.
+		authModules []string
 	}
 )
 
@@ -48,11 +59,31 @@ func New(config *Config) (*GethExecutionNode, error) {
 	// In Polaris we don't use P2P at the geth level.
 	gethNode.SetP2PDisabled(true)
 
+	// Serve the authenticated methods of the polaris namespace, such as the admin methods, on
+	// the auth port, which only serves the eth and engine namespaces by default.
+	authModules := slices.Clone(node.DefaultAuthModules)
+	if !slices.Contains(authModules, polarisNamespace) {
+		authModules = append(authModules, polarisNamespace)
+	}
+
 	return &GethExecutionNode{
-		Node: gethNode,
+		Node:        gethNode,
+		authModules: authModules,
 	}, nil
 }
 
+// Start starts the node, serving its auth modules on the auth port. Geth reads the modules of
+// the auth port from node.DefaultAuthModules when its endpoints start, so they are only swapped
+// in while the node starts and restored afterwards.
+func (n *GethExecutionNode) Start() error {
+	authModulesMu.Lock()
+	defer authModulesMu.Unlock()
+	defaults := node.DefaultAuthModules
+	node.DefaultAuthModules = n.authModules
+	defer func() { node.DefaultAuthModules = defaults }()
+	return n.Node.Start()
+}
+
 // ExtRPCEnabled returns whether or not the external RPC service is enabled.
 func (n *GethExecutionNode) ExtRPCEnabled() bool {
 	return n.Node.Config().ExtRPCEnabled()
@@ -70,8 +101,8 @@ func DefaultGethNodeConfig() *node.Config {
 	nodeCfg.P2P.NoDiscovery = true
 	nodeCfg.P2P.MaxPeers = 0
 	nodeCfg.Name = clientIdentifier
-	nodeCfg.HTTPModules = append(nodeCfg.HTTPModules, "eth", "txpool")
-	nodeCfg.WSModules = append(nodeCfg.WSModules, "eth")
+	nodeCfg.HTTPModules = append(nodeCfg.HTTPModules, "eth", "txpool", "polaris")
+	nodeCfg.WSModules = append(nodeCfg.WSModules, "eth", "polaris")
 	nodeCfg.HTTPHost = "0.0.0.0"
 	nodeCfg.WSHost = "0.0.0.0"
 	nodeCfg.WSOrigins = []string{"*"}
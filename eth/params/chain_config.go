@@ -58,4 +58,5 @@ var DefaultChainConfig = &params.ChainConfig{
 	TerminalTotalDifficulty:       big.NewInt(0),
 	TerminalTotalDifficultyPassed: true,
 	ShanghaiTime:                  &zero,
+	CancunTime:                    &zero,
 }
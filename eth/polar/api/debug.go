@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package polarapi
+
+import (
+	"context"
+
+	"github.com/berachain/polaris/eth/core"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethapi"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// DebugBackend is the collection of methods required to satisfy the debug
+// RPC API.
+type DebugBackend interface {
+	BadBlocks() []*core.BadBlock
+	ChainConfig() *params.ChainConfig
+	RewindHead(number uint64) error
+}
+
+// DebugAPI is the collection of debug RPC API methods that are not served by the tracers.
+type DebugAPI interface {
+	GetBadBlocks(ctx context.Context) ([]*BadBlockArgs, error)
+	SetHead(ctx context.Context, number hexutil.Uint64) error
+}
+
+// BadBlockArgs represents the entries in the list returned when bad blocks are queried.
+type BadBlockArgs struct {
+	Hash   common.Hash            `json:"hash"`
+	Block  map[string]interface{} `json:"block"`
+	RLP    string                 `json:"rlp"`
+	Reason string                 `json:"reason"`
+}
+
+// debugAPI offers debugging related RPC methods.
+type debugAPI struct {
+	b DebugBackend
+}
+
+// NewDebugAPI creates a new debug API instance.
+func NewDebugAPI(b DebugBackend) DebugAPI {
+	return &debugAPI{b}
+}
+
+// GetBadBlocks returns the blocks that were most recently rejected by the chain, along with
+// the reason they were rejected.
+func (api *debugAPI) GetBadBlocks(_ context.Context) ([]*BadBlockArgs, error) {
+	var (
+		badBlocks = api.b.BadBlocks()
+		results   = make([]*BadBlockArgs, 0, len(badBlocks))
+	)
+	for _, badBlock := range badBlocks {
+		var (
+			blockRlp  string
+			blockJSON map[string]interface{}
+		)
+		if rlpBytes, err := rlp.EncodeToBytes(badBlock.Block); err != nil {
+			blockRlp = err.Error() // Hacky, but hey, it works
+		} else {
+			blockRlp = hexutil.Encode(rlpBytes)
+		}
+		blockJSON = ethapi.RPCMarshalBlock(badBlock.Block, true, true, api.b.ChainConfig())
+		results = append(results, &BadBlockArgs{
+			Hash:   badBlock.Block.Hash(),
+			Block:  blockJSON,
+			RLP:    blockRlp,
+			Reason: badBlock.Reason,
+		})
+	}
+	return results, nil
+}
+
+// SetHead rewinds the head of the chain to a previous block. It overrides the method of the
+// same name served by go-ethereum, in order to return an error when rewinding is not possible
+// instead of silently doing nothing.
+func (api *debugAPI) SetHead(_ context.Context, number hexutil.Uint64) error {
+	return api.b.RewindHead(uint64(number))
+}
@@ -0,0 +1,230 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package polarapi
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// PolarisBackend is the collection of methods required to satisfy the polaris
+// RPC API.
+type PolarisBackend interface {
+	BlockByNumberOrHash(
+		ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash,
+	) (*ethtypes.Block, error)
+	GetBlobSidecars(ctx context.Context, blockHash common.Hash) ([]*ethtypes.BlobTxSidecar, error)
+	TxPoolStatus(hash common.Hash) txpool.TxStatus
+	HostTxStatus(hash common.Hash) *HostTxStatus
+	CurrentBlock() *ethtypes.Header
+	EarliestBlockNumber() uint64
+	TxCosmosEvents(hash common.Hash) ([]*CosmosEvent, error)
+	SubscribeCosmosEvents(ch chan<- *CosmosBlockEvents) (event.Subscription, error)
+	SubscribeValidatorSetChanges(ch chan<- *RPCValidatorSetChange) (event.Subscription, error)
+	SubscribeProposalStatus(ch chan<- *RPCProposalStatus) (event.Subscription, error)
+}
+
+// PolarisAPI is the collection of polaris RPC API methods.
+type PolarisAPI interface {
+	GetBlobSidecars(
+		ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash,
+	) ([]*RPCBlobSidecar, error)
+	TxPoolStatus(ctx context.Context, hash common.Hash) (*RPCTxPoolStatus, error)
+	HistoryStatus(ctx context.Context) (*RPCHistoryStatus, error)
+	GetTransactionCosmosEvents(ctx context.Context, hash common.Hash) ([]*CosmosEvent, error)
+	NewCosmosEvents(ctx context.Context, filter *CosmosEventFilter) (*rpc.Subscription, error)
+	ValidatorSetChanges(ctx context.Context) (*rpc.Subscription, error)
+	ProposalStatus(ctx context.Context) (*rpc.Subscription, error)
+}
+
+// RPCBlobSidecar is the blob sidecar of a blob transaction included in a block.
+type RPCBlobSidecar struct {
+	BlockHash   common.Hash          `json:"blockHash"`
+	BlockNumber hexutil.Uint64       `json:"blockNumber"`
+	TxHash      common.Hash          `json:"transactionHash"`
+	TxIndex     hexutil.Uint64       `json:"transactionIndex"`
+	Blobs       []kzg4844.Blob       `json:"blobs"`
+	Commitments []kzg4844.Commitment `json:"commitments"`
+	Proofs      []kzg4844.Proof      `json:"proofs"`
+}
+
+// HostTxStatus is the host chain's view of a transaction that has gone through the txpool.
+type HostTxStatus struct {
+	// RemoteSeen is true if the transaction was received from the host chain's mempool.
+	RemoteSeen bool
+	// RemoteSeenAt is the unix timestamp at which the transaction was first received remotely.
+	RemoteSeenAt int64
+	// BroadcastRetries is the number of times broadcasting the transaction has been retried.
+	BroadcastRetries int
+	// BroadcastToHost is true if the transaction has been broadcast to the host chain's mempool
+	// and has not been ejected from it or included in a block since.
+	BroadcastToHost bool
+	// InHostMempool is whether the host chain's mempool currently holds the transaction, as
+	// reported by the host chain itself. It is nil if that cannot be determined.
+	InHostMempool *bool
+	// EjectionReason is the reason the transaction was last ejected from the host chain's
+	// mempool, if any.
+	EjectionReason string
+}
+
+// RPCTxPoolStatus is the status of a transaction in both the txpool and the host chain's mempool.
+type RPCTxPoolStatus struct {
+	Hash             common.Hash     `json:"hash"`
+	Status           string          `json:"status"`
+	RemoteSeen       bool            `json:"remoteSeen"`
+	RemoteSeenAt     *hexutil.Uint64 `json:"remoteSeenAt,omitempty"`
+	BroadcastRetries hexutil.Uint    `json:"broadcastRetries"`
+	BroadcastToHost  bool            `json:"broadcastToHost"`
+	InHostMempool    *bool           `json:"inHostMempool,omitempty"`
+	EjectionReason   string          `json:"ejectionReason,omitempty"`
+}
+
+// RPCHistoryStatus is the range of blocks whose historical data is available.
+type RPCHistoryStatus struct {
+	EarliestBlock hexutil.Uint64 `json:"earliestBlock"`
+	LatestBlock   hexutil.Uint64 `json:"latestBlock"`
+}
+
+// CosmosEvent is a Cosmos event emitted by a precompile during the execution of a transaction.
+type CosmosEvent struct {
+	Type       string                 `json:"type"`
+	Attributes []CosmosEventAttribute `json:"attributes"`
+}
+
+// CosmosEventAttribute is an attribute of a Cosmos event.
+type CosmosEventAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// polarisAPI offers Polaris specific RPC methods.
+type polarisAPI struct {
+	b PolarisBackend
+}
+
+// NewPolarisAPI creates a new polaris API instance.
+func NewPolarisAPI(b PolarisBackend) PolarisAPI {
+	return &polarisAPI{b}
+}
+
+// GetBlobSidecars returns the blob sidecars of the blob transactions included in the given
+// block. Sidecars are only retained for a limited number of blocks, after which an empty list
+// is returned.
+func (api *polarisAPI) GetBlobSidecars(
+	ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash,
+) ([]*RPCBlobSidecar, error) {
+	block, err := api.b.BlockByNumberOrHash(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	sidecars, err := api.b.GetBlobSidecars(ctx, block.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	// Sidecars are stored in the order of the blob transactions in the block.
+	result := make([]*RPCBlobSidecar, 0, len(sidecars))
+	for i, tx := range block.Transactions() {
+		if tx.Type() != ethtypes.BlobTxType || len(result) == len(sidecars) {
+			continue
+		}
+		sidecar := sidecars[len(result)]
+		result = append(result, &RPCBlobSidecar{
+			BlockHash:   block.Hash(),
+			BlockNumber: hexutil.Uint64(block.NumberU64()),
+			TxHash:      tx.Hash(),
+			TxIndex:     hexutil.Uint64(i),
+			Blobs:       sidecar.Blobs,
+			Commitments: sidecar.Commitments,
+			Proofs:      sidecar.Proofs,
+		})
+	}
+	return result, nil
+}
+
+// TxPoolStatus returns the status of the transaction with the given hash in the txpool, along
+// with the host chain's view of it. This is intended for debugging transactions that are stuck.
+func (api *polarisAPI) TxPoolStatus(
+	_ context.Context, hash common.Hash,
+) (*RPCTxPoolStatus, error) {
+	result := &RPCTxPoolStatus{
+		Hash:   hash,
+		Status: txStatusString(api.b.TxPoolStatus(hash)),
+	}
+
+	host := api.b.HostTxStatus(hash)
+	if host == nil {
+		return result, nil
+	}
+	result.RemoteSeen = host.RemoteSeen
+	if host.RemoteSeen {
+		seenAt := hexutil.Uint64(host.RemoteSeenAt)
+		result.RemoteSeenAt = &seenAt
+	}
+	result.BroadcastRetries = hexutil.Uint(host.BroadcastRetries)
+	result.BroadcastToHost = host.BroadcastToHost
+	result.InHostMempool = host.InHostMempool
+	result.EjectionReason = host.EjectionReason
+	return result, nil
+}
+
+// HistoryStatus returns the range of blocks whose bodies, receipts and transactions are available.
+// Blocks before the earliest block have been pruned, except for the genesis block.
+func (api *polarisAPI) HistoryStatus(_ context.Context) (*RPCHistoryStatus, error) {
+	return &RPCHistoryStatus{
+		EarliestBlock: hexutil.Uint64(api.b.EarliestBlockNumber()),
+		LatestBlock:   hexutil.Uint64(api.b.CurrentBlock().Number.Uint64()),
+	}, nil
+}
+
+// GetTransactionCosmosEvents returns all the Cosmos events emitted by precompiles during the
+// execution of the transaction with the given hash, including the ones without an Eth log.
+func (api *polarisAPI) GetTransactionCosmosEvents(
+	_ context.Context, hash common.Hash,
+) ([]*CosmosEvent, error) {
+	return api.b.TxCosmosEvents(hash)
+}
+
+// txStatusString returns the human readable form of a txpool status.
+func txStatusString(status txpool.TxStatus) string {
+	switch status {
+	case txpool.TxStatusQueued:
+		return "queued"
+	case txpool.TxStatusPending:
+		return "pending"
+	case txpool.TxStatusIncluded:
+		return "included"
+	case txpool.TxStatusUnknown:
+		return "unknown"
+	default:
+		return "unknown"
+	}
+}
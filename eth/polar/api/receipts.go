@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package polarapi
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethapi"
+)
+
+// ReceiptBackend is the collection of methods required to satisfy the receipt
+// RPC API.
+type ReceiptBackend interface {
+	ethapi.Backend
+	TxCosmosEvents(hash common.Hash) ([]*CosmosEvent, error)
+}
+
+// ReceiptAPI is the collection of receipt RPC API methods that extend the eth namespace.
+type ReceiptAPI interface {
+	GetTransactionReceipt(ctx context.Context, hash common.Hash) (map[string]interface{}, error)
+}
+
+// receiptAPI offers receipt RPC methods that include the Cosmos events of transactions.
+type receiptAPI struct {
+	b     ReceiptBackend
+	txAPI *ethapi.TransactionAPI
+}
+
+// NewReceiptAPI creates a new receipt API instance.
+func NewReceiptAPI(b ReceiptBackend) ReceiptAPI {
+	return &receiptAPI{b, ethapi.NewTransactionAPI(b, new(ethapi.AddrLocker))}
+}
+
+// GetTransactionReceipt returns the transaction receipt for the given transaction hash, along
+// with the Cosmos events emitted by precompiles during its execution as `cosmosEvents`.
+func (api *receiptAPI) GetTransactionReceipt(
+	ctx context.Context, hash common.Hash,
+) (map[string]interface{}, error) {
+	fields, err := api.txAPI.GetTransactionReceipt(ctx, hash)
+	if fields == nil || err != nil {
+		return fields, err
+	}
+
+	events, err := api.b.TxCosmosEvents(hash)
+	if err != nil {
+		return nil, err
+	}
+	fields["cosmosEvents"] = events
+	return fields, nil
+}
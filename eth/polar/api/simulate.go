@@ -0,0 +1,389 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package polarapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/berachain/polaris/eth/core/state"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/ethapi"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+const (
+	// maxSimulateBlocks is the maximum number of blocks that can be simulated in one request.
+	maxSimulateBlocks = 256
+	// simulateTimestampIncrement is the default timestamp increment between simulated blocks.
+	simulateTimestampIncrement = 12
+
+	// errCodeReverted and errCodeVMError are the JSON-RPC error codes of reverted calls and
+	// calls that failed with any other EVM error.
+	errCodeReverted = 3
+	errCodeVMError  = -32015
+)
+
+var (
+	errEmptySimulation    = errors.New("empty simulation")
+	errTooManyBlocks      = fmt.Errorf("too many blocks, maximum is %d", maxSimulateBlocks)
+	errBlockNumberInvalid = errors.New("block numbers must be in order")
+	errBlockGasLimit      = errors.New("block gas limit reached")
+	errGasCapReached      = errors.New("rpc gas cap reached")
+)
+
+// SimulateBackend is the collection of methods required to satisfy the simulate
+// RPC API.
+type SimulateBackend interface {
+	StateAndHeaderByNumberOrHash(
+		ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash,
+	) (state.StateDB, *ethtypes.Header, error)
+	GetBlockContext(ctx context.Context, header *ethtypes.Header) *vm.BlockContext
+	GetEVM(ctx context.Context, msg *core.Message, state state.StateDB,
+		header *ethtypes.Header, vmConfig *vm.Config, blockCtx *vm.BlockContext) *vm.EVM
+	ChainConfig() *params.ChainConfig
+	RPCGasCap() uint64
+	RPCEVMTimeout() time.Duration
+}
+
+// SimulateAPI is the collection of RPC API methods that simulate sequences of calls.
+type SimulateAPI interface {
+	SimulateV1(
+		ctx context.Context, opts SimOpts, blockNrOrHash *rpc.BlockNumberOrHash,
+	) ([]*RPCSimBlockResult, error)
+}
+
+// SimOpts are the inputs to eth_simulateV1.
+type SimOpts struct {
+	BlockStateCalls []SimBlock `json:"blockStateCalls"`
+	Validation      bool       `json:"validation"`
+}
+
+// SimBlock is a batch of calls to be simulated sequentially in the same block, on top of the
+// given state and block overrides.
+type SimBlock struct {
+	BlockOverrides *ethapi.BlockOverrides   `json:"blockOverrides"`
+	StateOverrides *ethapi.StateOverride    `json:"stateOverrides"`
+	Calls          []ethapi.TransactionArgs `json:"calls"`
+}
+
+// RPCSimBlockResult is the result of a simulated block.
+type RPCSimBlockResult struct {
+	Number        hexutil.Uint64      `json:"number"`
+	Hash          common.Hash         `json:"hash"`
+	ParentHash    common.Hash         `json:"parentHash"`
+	Timestamp     hexutil.Uint64      `json:"timestamp"`
+	GasLimit      hexutil.Uint64      `json:"gasLimit"`
+	GasUsed       hexutil.Uint64      `json:"gasUsed"`
+	FeeRecipient  common.Address      `json:"miner"`
+	BaseFeePerGas *hexutil.Big        `json:"baseFeePerGas,omitempty"`
+	Calls         []*RPCSimCallResult `json:"calls"`
+}
+
+// RPCSimCallResult is the result of a simulated call.
+type RPCSimCallResult struct {
+	ReturnValue hexutil.Bytes    `json:"returnData"`
+	Logs        []*ethtypes.Log  `json:"logs"`
+	GasUsed     hexutil.Uint64   `json:"gasUsed"`
+	Status      hexutil.Uint64   `json:"status"`
+	Error       *RPCSimCallError `json:"error,omitempty"`
+}
+
+// RPCSimCallError is the error of a simulated call that failed.
+type RPCSimCallError struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Data    string `json:"data,omitempty"`
+}
+
+// simulateAPI offers RPC methods that simulate sequences of calls.
+type simulateAPI struct {
+	b SimulateBackend
+}
+
+// NewSimulateAPI creates a new simulate API instance.
+func NewSimulateAPI(b SimulateBackend) SimulateAPI {
+	return &simulateAPI{b}
+}
+
+// SimulateV1 executes a series of blocks of calls on top of the state at the given block. The
+// state is threaded through every call, so later calls observe the effects of earlier ones,
+// including the host chain side effects of precompile calls. Nothing is persisted.
+func (api *simulateAPI) SimulateV1(
+	ctx context.Context, opts SimOpts, blockNrOrHash *rpc.BlockNumberOrHash,
+) ([]*RPCSimBlockResult, error) {
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, errEmptySimulation
+	} else if len(opts.BlockStateCalls) > maxSimulateBlocks {
+		return nil, errTooManyBlocks
+	}
+	if blockNrOrHash == nil {
+		latest := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+		blockNrOrHash = &latest
+	}
+
+	sdb, parent, err := api.b.StateAndHeaderByNumberOrHash(ctx, *blockNrOrHash)
+	if sdb == nil || err != nil {
+		return nil, err
+	}
+
+	// Setup a context so the simulation is cancelled once it completes or times out.
+	var cancel context.CancelFunc
+	if timeout := api.b.RPCEVMTimeout(); timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	var (
+		results = make([]*RPCSimBlockResult, len(opts.BlockStateCalls))
+		gasCap  = api.b.RPCGasCap()
+	)
+	if gasCap == 0 {
+		gasCap = math.MaxUint64
+	}
+	for i := range opts.BlockStateCalls {
+		block := &opts.BlockStateCalls[i]
+		var header *ethtypes.Header
+		if header, err = makeSimHeader(parent, block.BlockOverrides); err != nil {
+			return nil, err
+		}
+		if err = block.StateOverrides.Apply(sdb); err != nil {
+			return nil, err
+		}
+		if results[i], err = api.simulateBlock(
+			ctx, sdb, header, block, opts.Validation, &gasCap,
+		); err != nil {
+			return nil, err
+		}
+		parent = header
+	}
+	return results, nil
+}
+
+// simulateBlock executes the calls of a simulated block sequentially on the given state.
+func (api *simulateAPI) simulateBlock(
+	ctx context.Context, sdb state.StateDB, header *ethtypes.Header, block *SimBlock,
+	validation bool, gasCap *uint64,
+) (*RPCSimBlockResult, error) {
+	var (
+		blockCtx = api.b.GetBlockContext(ctx, header)
+		vmConfig = &vm.Config{NoBaseFee: !validation}
+		calls    = make([]*RPCSimCallResult, len(block.Calls))
+		txs      = make([]*ethtypes.Transaction, len(block.Calls))
+		gasUsed  uint64
+	)
+	block.BlockOverrides.Apply(blockCtx)
+
+	for i, args := range block.Calls {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		// Default the gas of the call to whatever is left in the block. A gas cap of zero is
+		// uncapped to ToMessage, so an exhausted gas cap is rejected here.
+		if args.Gas == nil {
+			remaining := hexutil.Uint64(header.GasLimit - gasUsed)
+			args.Gas = &remaining
+		}
+		if *gasCap == 0 {
+			return nil, fmt.Errorf("%w: call %d", errGasCapReached, i)
+		}
+		msg, err := args.ToMessage(*gasCap, header.BaseFee)
+		if err != nil {
+			return nil, err
+		}
+		msg.SkipAccountChecks = !validation
+
+		// ToMessage leaves the nonce unset, so default it to the sender's nonce in the threaded
+		// state, which ApplyMessage increments, so that consecutive calls of a sender validate.
+		if args.Nonce != nil {
+			msg.Nonce = uint64(*args.Nonce)
+		} else {
+			msg.Nonce = sdb.GetNonce(msg.From)
+		}
+		if gasUsed+msg.GasLimit > header.GasLimit {
+			return nil, fmt.Errorf("%w: call %d", errBlockGasLimit, i)
+		}
+
+		txs[i] = simTransaction(api.b.ChainConfig().ChainID, msg)
+		sdb.SetTxContext(txs[i].Hash(), i)
+		evm := api.b.GetEVM(ctx, msg, sdb, header, vmConfig, blockCtx)
+		result, err := applySimMessage(ctx, evm, msg)
+		if err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+		if err = sdb.Error(); err != nil {
+			return nil, err
+		}
+
+		calls[i] = &RPCSimCallResult{
+			ReturnValue: result.Return(),
+			Logs:        sdb.GetLogs(txs[i].Hash(), header.Number.Uint64(), common.Hash{}),
+			GasUsed:     hexutil.Uint64(result.UsedGas),
+			Status:      hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+		}
+		if result.Failed() {
+			calls[i].Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+			calls[i].Error = simCallError(result)
+		}
+		sdb.Finalise(true)
+
+		gasUsed += result.UsedGas
+		*gasCap -= min(*gasCap, result.UsedGas)
+	}
+
+	// Now that the block is complete, seal its header, so that the next block's parent hash is
+	// its hash, and fill in its hash on the logs of the calls.
+	header.GasUsed = gasUsed
+	*header = *ethtypes.NewBlock(header, txs, nil, nil, trie.NewStackTrie(nil)).Header()
+	hash := header.Hash()
+	for _, call := range calls {
+		for _, log := range call.Logs {
+			log.BlockHash = hash
+		}
+	}
+
+	var baseFee *hexutil.Big
+	if header.BaseFee != nil {
+		baseFee = (*hexutil.Big)(header.BaseFee)
+	}
+	return &RPCSimBlockResult{
+		Number:        hexutil.Uint64(header.Number.Uint64()),
+		Hash:          hash,
+		ParentHash:    header.ParentHash,
+		Timestamp:     hexutil.Uint64(header.Time),
+		GasLimit:      hexutil.Uint64(header.GasLimit),
+		GasUsed:       hexutil.Uint64(gasUsed),
+		FeeRecipient:  header.Coinbase,
+		BaseFeePerGas: baseFee,
+		Calls:         calls,
+	}, nil
+}
+
+// applySimMessage executes the message, cancelling the EVM once the context is done.
+func applySimMessage(
+	ctx context.Context, evm *vm.EVM, msg *core.Message,
+) (*core.ExecutionResult, error) {
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			evm.Cancel()
+		case <-done:
+		}
+	}()
+
+	result, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(math.MaxUint64))
+	if evm.Cancelled() {
+		return nil, fmt.Errorf("execution aborted: %w", ctx.Err())
+	}
+	return result, err
+}
+
+// makeSimHeader returns the header of the simulated block following the given parent, with the
+// given block overrides applied.
+func makeSimHeader(
+	parent *ethtypes.Header, overrides *ethapi.BlockOverrides,
+) (*ethtypes.Header, error) {
+	header := ethtypes.CopyHeader(parent)
+	header.ParentHash = parent.Hash()
+	header.Number = new(big.Int).Add(parent.Number, common.Big1)
+	header.Time = parent.Time + simulateTimestampIncrement
+	header.GasUsed = 0
+	if overrides == nil {
+		return header, nil
+	}
+
+	if overrides.Number != nil {
+		if overrides.Number.ToInt().Cmp(parent.Number) <= 0 {
+			return nil, fmt.Errorf("%w: block %d after %d", errBlockNumberInvalid,
+				overrides.Number.ToInt(), parent.Number)
+		}
+		header.Number = new(big.Int).Set(overrides.Number.ToInt())
+	}
+	if overrides.Time != nil {
+		header.Time = uint64(*overrides.Time)
+	}
+	if overrides.GasLimit != nil {
+		header.GasLimit = uint64(*overrides.GasLimit)
+	}
+	if overrides.Coinbase != nil {
+		header.Coinbase = *overrides.Coinbase
+	}
+	if overrides.Random != nil {
+		header.MixDigest = *overrides.Random
+	}
+	if overrides.BaseFee != nil {
+		header.BaseFee = new(big.Int).Set(overrides.BaseFee.ToInt())
+	}
+	return header, nil
+}
+
+// simTransaction returns the transaction equivalent of a simulated call, which identifies the
+// call in its logs.
+func simTransaction(chainID *big.Int, msg *core.Message) *ethtypes.Transaction {
+	return ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:    chainID,
+		Nonce:      msg.Nonce,
+		GasTipCap:  msg.GasTipCap,
+		GasFeeCap:  msg.GasFeeCap,
+		Gas:        msg.GasLimit,
+		To:         msg.To,
+		Value:      msg.Value,
+		Data:       msg.Data,
+		AccessList: msg.AccessList,
+	})
+}
+
+// simCallError returns the RPC error of a failed simulated call.
+func simCallError(result *core.ExecutionResult) *RPCSimCallError {
+	if errors.Is(result.Err, vm.ErrExecutionReverted) {
+		revert := result.Revert()
+		message := vm.ErrExecutionReverted.Error()
+		if reason, err := abi.UnpackRevert(revert); err == nil {
+			message = fmt.Sprintf("%s: %s", message, reason)
+		}
+		return &RPCSimCallError{
+			Message: message,
+			Code:    errCodeReverted,
+			Data:    hexutil.Encode(revert),
+		}
+	}
+	return &RPCSimCallError{Message: result.Err.Error(), Code: errCodeVMError}
+}
@@ -0,0 +1,292 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package polarapi
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/berachain/polaris/eth/core/state"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	gethstate "github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/ethapi"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAPI(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "eth/polar/api")
+}
+
+var (
+	sender  = common.Address{0x1}
+	counter = common.Address{0x2}
+	// counterCode increments storage slot 0, logs and returns the new value.
+	counterCode = hexutil.MustDecode(
+		"0x6000546001018060005560006000a060005260206000f3",
+	)
+)
+
+// simBackend is a SimulateBackend that executes calls on an in-memory state.
+type simBackend struct {
+	sdb    state.StateDB
+	parent *ethtypes.Header
+	gasCap uint64
+}
+
+func (b *simBackend) StateAndHeaderByNumberOrHash(
+	context.Context, rpc.BlockNumberOrHash,
+) (state.StateDB, *ethtypes.Header, error) {
+	return b.sdb, b.parent, nil
+}
+
+func (b *simBackend) GetBlockContext(_ context.Context, header *ethtypes.Header) *vm.BlockContext {
+	return &vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		GetHash:     func(uint64) common.Hash { return common.Hash{} },
+		Coinbase:    header.Coinbase,
+		GasLimit:    header.GasLimit,
+		BlockNumber: new(big.Int).Set(header.Number),
+		Time:        header.Time,
+		Difficulty:  new(big.Int),
+		BaseFee:     header.BaseFee,
+	}
+}
+
+func (b *simBackend) GetEVM(
+	_ context.Context, msg *core.Message, sdb state.StateDB, _ *ethtypes.Header,
+	vmConfig *vm.Config, blockCtx *vm.BlockContext,
+) *vm.EVM {
+	return vm.NewEVM(*blockCtx, core.NewEVMTxContext(msg), sdb, b.ChainConfig(), *vmConfig)
+}
+
+func (b *simBackend) ChainConfig() *params.ChainConfig { return params.TestChainConfig }
+func (b *simBackend) RPCGasCap() uint64                { return b.gasCap }
+func (b *simBackend) RPCEVMTimeout() time.Duration     { return 0 }
+
+var _ = Describe("Simulate", func() {
+	var (
+		b      *simBackend
+		api    SimulateAPI
+		parent *ethtypes.Header
+	)
+
+	// call returns a call of the counter contract by the sender.
+	call := func() ethapi.TransactionArgs {
+		from, to, maxFee := sender, counter, (*hexutil.Big)(big.NewInt(params.GWei))
+		return ethapi.TransactionArgs{From: &from, To: &to, MaxFeePerGas: maxFee}
+	}
+
+	BeforeEach(func() {
+		sdb, err := gethstate.New(
+			ethtypes.EmptyRootHash, gethstate.NewDatabase(rawdb.NewMemoryDatabase()), nil,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		sdb.SetNonce(sender, 5)
+		sdb.SetBalance(sender, big.NewInt(params.Ether))
+		sdb.SetCode(counter, counterCode)
+
+		parent = &ethtypes.Header{
+			Number:   big.NewInt(10),
+			Time:     100,
+			GasLimit: 30_000_000,
+			GasUsed:  21_000,
+			BaseFee:  big.NewInt(params.GWei),
+		}
+		b = &simBackend{sdb: sdb, parent: parent}
+		api = NewSimulateAPI(b)
+	})
+
+	It("should make the header of the next simulated block", func() {
+		header, err := makeSimHeader(parent, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(header.Number.Uint64()).To(Equal(uint64(11)))
+		Expect(header.ParentHash).To(Equal(parent.Hash()))
+		Expect(header.Time).To(Equal(uint64(100 + simulateTimestampIncrement)))
+		Expect(header.GasUsed).To(BeZero())
+		Expect(header.BaseFee).To(Equal(parent.BaseFee))
+
+		number, timestamp := (*hexutil.Big)(big.NewInt(20)), hexutil.Uint64(500)
+		gasLimit, coinbase := hexutil.Uint64(1000), common.Address{0x3}
+		header, err = makeSimHeader(parent, &ethapi.BlockOverrides{
+			Number: number, Time: &timestamp, GasLimit: &gasLimit, Coinbase: &coinbase,
+			BaseFee: (*hexutil.Big)(big.NewInt(7)),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(header.Number.Uint64()).To(Equal(uint64(20)))
+		Expect(header.Time).To(Equal(uint64(500)))
+		Expect(header.GasLimit).To(Equal(uint64(1000)))
+		Expect(header.Coinbase).To(Equal(coinbase))
+		Expect(header.BaseFee.Int64()).To(Equal(int64(7)))
+		Expect(parent.Number.Uint64()).To(Equal(uint64(10)))
+
+		_, err = makeSimHeader(parent, &ethapi.BlockOverrides{
+			Number: (*hexutil.Big)(big.NewInt(10)),
+		})
+		Expect(err).To(MatchError(errBlockNumberInvalid))
+	})
+
+	It("should map failed calls to RPC errors", func() {
+		stringType, err := abi.NewType("string", "", nil)
+		Expect(err).ToNot(HaveOccurred())
+		reason, err := abi.Arguments{{Type: stringType}}.Pack("too low")
+		Expect(err).ToNot(HaveOccurred())
+		revert := append(hexutil.MustDecode("0x08c379a0"), reason...)
+
+		Expect(simCallError(&core.ExecutionResult{
+			Err: vm.ErrExecutionReverted, ReturnData: revert,
+		})).To(Equal(&RPCSimCallError{
+			Message: "execution reverted: too low",
+			Code:    errCodeReverted,
+			Data:    hexutil.Encode(revert),
+		}))
+		Expect(simCallError(&core.ExecutionResult{Err: vm.ErrOutOfGas})).To(Equal(
+			&RPCSimCallError{Message: vm.ErrOutOfGas.Error(), Code: errCodeVMError},
+		))
+	})
+
+	It("should thread state and nonces through calls and blocks", func() {
+		number := (*hexutil.Big)(big.NewInt(15))
+		results, err := api.SimulateV1(context.Background(), SimOpts{
+			Validation: true,
+			BlockStateCalls: []SimBlock{
+				{Calls: []ethapi.TransactionArgs{call(), call()}},
+				{
+					BlockOverrides: &ethapi.BlockOverrides{Number: number},
+					Calls:          []ethapi.TransactionArgs{call()},
+				},
+			},
+		}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(HaveLen(2))
+
+		Expect(uint64(results[0].Number)).To(Equal(uint64(11)))
+		Expect(results[0].ParentHash).To(Equal(parent.Hash()))
+		Expect(results[0].Calls).To(HaveLen(2))
+		for i, result := range results[0].Calls {
+			Expect(result.Error).To(BeNil())
+			Expect(result.Status).To(Equal(hexutil.Uint64(ethtypes.ReceiptStatusSuccessful)))
+			Expect(new(big.Int).SetBytes(result.ReturnValue).Int64()).To(Equal(int64(i + 1)))
+			Expect(result.Logs).To(HaveLen(1))
+			Expect(result.Logs[0].BlockHash).To(Equal(results[0].Hash))
+			Expect(result.Logs[0].BlockNumber).To(Equal(uint64(11)))
+		}
+		Expect(uint64(results[0].GasUsed)).To(Equal(
+			uint64(results[0].Calls[0].GasUsed + results[0].Calls[1].GasUsed),
+		))
+
+		Expect(uint64(results[1].Number)).To(Equal(uint64(15)))
+		Expect(results[1].ParentHash).To(Equal(results[0].Hash))
+		Expect(new(big.Int).SetBytes(results[1].Calls[0].ReturnValue).Int64()).To(Equal(int64(3)))
+		Expect(results[1].Calls[0].Logs[0].BlockHash).To(Equal(results[1].Hash))
+		Expect(b.sdb.GetNonce(sender)).To(Equal(uint64(8)))
+	})
+
+	It("should validate the nonces of calls", func() {
+		stale := call()
+		nonce := hexutil.Uint64(4)
+		stale.Nonce = &nonce
+		_, err := api.SimulateV1(context.Background(), SimOpts{
+			Validation:      true,
+			BlockStateCalls: []SimBlock{{Calls: []ethapi.TransactionArgs{stale}}},
+		}, nil)
+		Expect(err).To(MatchError(core.ErrNonceTooLow))
+
+		// Without validation, the nonce of the call is not checked.
+		_, err = api.SimulateV1(context.Background(), SimOpts{
+			BlockStateCalls: []SimBlock{{Calls: []ethapi.TransactionArgs{stale}}},
+		}, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should apply state overrides", func() {
+		nonce := hexutil.Uint64(9)
+		stale := call()
+		stale.Nonce = &nonce
+		results, err := api.SimulateV1(context.Background(), SimOpts{
+			Validation: true,
+			BlockStateCalls: []SimBlock{{
+				StateOverrides: &ethapi.StateOverride{
+					sender: ethapi.OverrideAccount{Nonce: &nonce},
+					counter: ethapi.OverrideAccount{
+						StateDiff: &map[common.Hash]common.Hash{{}: common.BigToHash(big.NewInt(41))},
+					},
+				},
+				Calls: []ethapi.TransactionArgs{stale},
+			}},
+		}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(new(big.Int).SetBytes(results[0].Calls[0].ReturnValue).Int64()).To(Equal(int64(42)))
+	})
+
+	It("should account the gas of calls against the RPC gas cap", func() {
+		b.gasCap = 60_000
+		_, err := api.SimulateV1(context.Background(), SimOpts{
+			BlockStateCalls: []SimBlock{{Calls: []ethapi.TransactionArgs{call(), call()}}},
+		}, nil)
+		Expect(err).To(MatchError(core.ErrIntrinsicGas))
+
+		b.gasCap = 0
+		results, err := api.SimulateV1(context.Background(), SimOpts{
+			BlockStateCalls: []SimBlock{{Calls: []ethapi.TransactionArgs{call(), call()}}},
+		}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results[0].Calls).To(HaveLen(2))
+	})
+
+	It("should reject invalid simulations", func() {
+		_, err := api.SimulateV1(context.Background(), SimOpts{}, nil)
+		Expect(err).To(MatchError(errEmptySimulation))
+
+		_, err = api.SimulateV1(context.Background(), SimOpts{
+			BlockStateCalls: make([]SimBlock, maxSimulateBlocks+1),
+		}, nil)
+		Expect(err).To(MatchError(errTooManyBlocks))
+
+		gasLimit, gas := hexutil.Uint64(50_000), hexutil.Uint64(30_000)
+		limited := call()
+		limited.Gas = &gas
+		_, err = api.SimulateV1(context.Background(), SimOpts{
+			BlockStateCalls: []SimBlock{{
+				BlockOverrides: &ethapi.BlockOverrides{GasLimit: &gasLimit},
+				Calls:          []ethapi.TransactionArgs{limited, limited},
+			}},
+		}, nil)
+		Expect(err).To(MatchError(errBlockGasLimit))
+	})
+})
@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package polarapi
+
+import (
+	"context"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// subscriptionBufferSize is the size of the channels used to receive the host chain's events.
+const subscriptionBufferSize = 128
+
+// CosmosBlockEvents is the collection of Cosmos events emitted in a block of the host chain.
+type CosmosBlockEvents struct {
+	Height uint64
+	Events []*RPCCosmosEvent
+}
+
+// RPCCosmosEvent is a Cosmos event emitted while finalizing a block of the host chain.
+type RPCCosmosEvent struct {
+	Height hexutil.Uint64 `json:"height"`
+	// TxIndex is the index of the transaction that emitted the event, or nil if the event was
+	// emitted outside of a transaction, e.g. during the begin or end block.
+	TxIndex *hexutil.Uint64 `json:"transactionIndex,omitempty"`
+	CosmosEvent
+}
+
+// RPCValidatorSetChange is a set of updates to the validator set of the host chain.
+type RPCValidatorSetChange struct {
+	Height  hexutil.Uint64        `json:"height"`
+	Updates []*RPCValidatorUpdate `json:"updates"`
+}
+
+// RPCValidatorUpdate is an update to a single validator. A power of zero removes the validator
+// from the validator set.
+type RPCValidatorUpdate struct {
+	PubKeyType string         `json:"pubKeyType"`
+	PubKey     hexutil.Bytes  `json:"pubKey"`
+	Power      hexutil.Uint64 `json:"power"`
+}
+
+// RPCProposalStatus is a change to the status of a governance proposal.
+type RPCProposalStatus struct {
+	Height     hexutil.Uint64 `json:"height"`
+	ProposalID hexutil.Uint64 `json:"proposalId"`
+	Status     string         `json:"status"`
+}
+
+// CosmosEventFilter selects the Cosmos events delivered to a subscription. An event matches if
+// its type is one of the given types, or any type if none are given, and it has all the given
+// attributes. An attribute with an empty value matches any value.
+type CosmosEventFilter struct {
+	Types      []string               `json:"types"`
+	Attributes []CosmosEventAttribute `json:"attributes"`
+}
+
+// Matches returns true if the given event is selected by the filter.
+func (f *CosmosEventFilter) Matches(ev *CosmosEvent) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.Types) > 0 && !slices.Contains(f.Types, ev.Type) {
+		return false
+	}
+	for _, want := range f.Attributes {
+		found := false
+		for _, attr := range ev.Attributes {
+			if attr.Key == want.Key && (want.Value == "" || attr.Value == want.Value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// NewCosmosEvents creates a subscription that is triggered for each Cosmos event emitted by the
+// host chain that matches the given filter.
+func (api *polarisAPI) NewCosmosEvents(
+	ctx context.Context, filter *CosmosEventFilter,
+) (*rpc.Subscription, error) {
+	ch := make(chan *CosmosBlockEvents, subscriptionBufferSize)
+	sub, err := api.b.SubscribeCosmosEvents(ch)
+	if err != nil {
+		return nil, err
+	}
+	return notify(ctx, sub, ch, func(notifier *rpc.Notifier, id rpc.ID, block *CosmosBlockEvents) {
+		for _, ev := range block.Events {
+			if filter.Matches(&ev.CosmosEvent) {
+				_ = notifier.Notify(id, ev)
+			}
+		}
+	})
+}
+
+// ValidatorSetChanges creates a subscription that is triggered each time the validator set of
+// the host chain changes.
+func (api *polarisAPI) ValidatorSetChanges(ctx context.Context) (*rpc.Subscription, error) {
+	ch := make(chan *RPCValidatorSetChange, subscriptionBufferSize)
+	sub, err := api.b.SubscribeValidatorSetChanges(ch)
+	if err != nil {
+		return nil, err
+	}
+	return notify(ctx, sub, ch, func(notifier *rpc.Notifier, id rpc.ID, c *RPCValidatorSetChange) {
+		_ = notifier.Notify(id, c)
+	})
+}
+
+// ProposalStatus creates a subscription that is triggered each time the status of a governance
+// proposal changes.
+func (api *polarisAPI) ProposalStatus(ctx context.Context) (*rpc.Subscription, error) {
+	ch := make(chan *RPCProposalStatus, subscriptionBufferSize)
+	sub, err := api.b.SubscribeProposalStatus(ch)
+	if err != nil {
+		return nil, err
+	}
+	return notify(ctx, sub, ch, func(notifier *rpc.Notifier, id rpc.ID, s *RPCProposalStatus) {
+		_ = notifier.Notify(id, s)
+	})
+}
+
+// notify creates an RPC subscription that forwards the values received on ch through send, until
+// either the RPC subscription or the feed subscription ends.
+func notify[T any](
+	ctx context.Context, sub event.Subscription, ch <-chan T,
+	send func(*rpc.Notifier, rpc.ID, T),
+) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		sub.Unsubscribe()
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+	go func() {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case v := <-ch:
+				send(notifier, rpcSub.ID, v)
+			case <-rpcSub.Err():
+				return
+			case <-sub.Err():
+				return
+			}
+		}
+	}()
+	return rpcSub, nil
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package polarapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// feedBackend is a PolarisBackend that streams the values sent on its feeds, and tracks the
+// subscriptions to them.
+type feedBackend struct {
+	PolarisBackend
+	cosmosEvents event.Feed
+	proposals    event.Feed
+	scope        event.SubscriptionScope
+}
+
+func (b *feedBackend) SubscribeCosmosEvents(
+	ch chan<- *CosmosBlockEvents,
+) (event.Subscription, error) {
+	return b.scope.Track(b.cosmosEvents.Subscribe(ch)), nil
+}
+
+func (b *feedBackend) SubscribeProposalStatus(
+	ch chan<- *RPCProposalStatus,
+) (event.Subscription, error) {
+	return b.scope.Track(b.proposals.Subscribe(ch)), nil
+}
+
+var _ = Describe("Subscriptions", func() {
+	var (
+		b      *feedBackend
+		client *rpc.Client
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		b = &feedBackend{}
+		server := rpc.NewServer()
+		Expect(server.RegisterName("polaris", NewPolarisAPI(b))).To(Succeed())
+		client = rpc.DialInProc(server)
+		ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+		DeferCleanup(func() {
+			cancel()
+			client.Close()
+			server.Stop()
+		})
+	})
+
+	// waitForSubscribers waits until the backend has the given number of subscribers, i.e. until
+	// the subscriptions made over RPC are subscribed to, or unsubscribed from, the backend.
+	waitForSubscribers := func(n int) {
+		Eventually(b.scope.Count).Should(Equal(n))
+	}
+
+	It("should only notify the cosmos events that match the filter", func() {
+		ch := make(chan *RPCCosmosEvent, 4)
+		sub, err := client.Subscribe(ctx, "polaris", ch, "newCosmosEvents", &CosmosEventFilter{
+			Types:      []string{"transfer"},
+			Attributes: []CosmosEventAttribute{{Key: "recipient", Value: "alice"}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		waitForSubscribers(1)
+
+		matching := &RPCCosmosEvent{Height: 7, CosmosEvent: CosmosEvent{
+			Type: "transfer", Attributes: []CosmosEventAttribute{
+				{Key: "recipient", Value: "alice"}, {Key: "amount", Value: "1abera"},
+			},
+		}}
+		b.cosmosEvents.Send(&CosmosBlockEvents{Height: 7, Events: []*RPCCosmosEvent{
+			{Height: 7, CosmosEvent: CosmosEvent{Type: "mint"}},
+			{Height: 7, CosmosEvent: CosmosEvent{
+				Type:       "transfer",
+				Attributes: []CosmosEventAttribute{{Key: "recipient", Value: "bob"}},
+			}},
+			matching,
+		}})
+		Eventually(ch).Should(Receive(Equal(matching)))
+		Consistently(ch, 100*time.Millisecond).ShouldNot(Receive())
+
+		// Unsubscribing over RPC unsubscribes from the backend.
+		sub.Unsubscribe()
+		waitForSubscribers(0)
+	})
+
+	It("should forward every proposal status", func() {
+		ch := make(chan *RPCProposalStatus, 4)
+		_, err := client.Subscribe(ctx, "polaris", ch, "proposalStatus")
+		Expect(err).ToNot(HaveOccurred())
+		waitForSubscribers(1)
+
+		status := &RPCProposalStatus{Height: 3, ProposalID: 1, Status: "PROPOSAL_STATUS_PASSED"}
+		b.proposals.Send(status)
+		Eventually(ch).Should(Receive(Equal(status)))
+	})
+
+	It("should unsubscribe from the backend without notifications", func() {
+		_, err := NewPolarisAPI(b).ProposalStatus(context.Background())
+		Expect(err).To(MatchError(rpc.ErrNotificationsUnsupported))
+		Expect(b.scope.Count()).To(BeZero())
+	})
+})
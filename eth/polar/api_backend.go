@@ -29,6 +29,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	pcore "github.com/berachain/polaris/eth/core"
@@ -43,6 +44,7 @@ import (
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/bloombits"
+	"github.com/ethereum/go-ethereum/core/txpool"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/eth/gasprice"
@@ -55,6 +57,16 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
+// errCosmosEventsUnavailable is returned when no provider of Cosmos events is registered.
+var errCosmosEventsUnavailable = errors.New("cosmos events are not available")
+
+// errSetHeadUnsupported is returned when the head of the chain is asked to be rewound.
+var errSetHeadUnsupported = errors.New("cannot set head while the node is running")
+
+// errSubscriptionsUnavailable is returned when no provider of host chain subscriptions is
+// registered.
+var errSubscriptionsUnavailable = errors.New("host chain subscriptions are not available")
+
 // Backend represents the backend object for a Polaris chain. It extends the standard
 // go-ethereum backend object.
 type (
@@ -62,6 +74,9 @@ type (
 		ethapi.Backend
 		polarapi.NetBackend
 		polarapi.Web3Backend
+		polarapi.PolarisBackend
+		polarapi.DebugBackend
+		polarapi.ReceiptBackend
 		tracers.Backend
 	}
 
@@ -75,12 +90,59 @@ type (
 		// PeerCount returns the current number of peers connected to the host chain.
 		PeerCount(ctx context.Context) (uint64, error)
 	}
+
+	// TxValidator defines methods that allow the host chain to reject transactions submitted
+	// over JSON-RPC before they are added to the txpool.
+	TxValidator interface {
+		// ValidateTx returns the reason the transaction is not admitted, if any.
+		ValidateTx(tx *ethtypes.Transaction) error
+		// RecordTx records that the transaction was accepted by the txpool.
+		RecordTx(tx *ethtypes.Transaction)
+	}
+
+	// TxStatusProvider defines a method that gives insight into how the host chain's mempool
+	// has handled a transaction.
+	TxStatusProvider interface {
+		// TxStatus returns the host chain's view of the transaction with the given hash.
+		TxStatus(hash common.Hash) *polarapi.HostTxStatus
+	}
+
+	// CosmosEventsProvider defines a method that gives access to the Cosmos events emitted by
+	// precompiles during the execution of transactions.
+	CosmosEventsProvider interface {
+		// TxCosmosEvents returns the Cosmos events emitted by the transaction with the given hash.
+		TxCosmosEvents(hash common.Hash) ([]*polarapi.CosmosEvent, error)
+	}
+
+	// SubscriptionProvider defines methods that allow subscribing to the results of the blocks
+	// finalized by the host chain.
+	SubscriptionProvider interface {
+		// SubscribeCosmosEvents subscribes to the Cosmos events emitted in each block.
+		SubscribeCosmosEvents(ch chan<- *polarapi.CosmosBlockEvents) event.Subscription
+		// SubscribeValidatorSetChanges subscribes to the changes of the validator set.
+		SubscribeValidatorSetChanges(ch chan<- *polarapi.RPCValidatorSetChange) event.Subscription
+		// SubscribeProposalStatus subscribes to the status changes of governance proposals.
+		SubscribeProposalStatus(ch chan<- *polarapi.RPCProposalStatus) event.Subscription
+	}
 )
 
+// RPCLimits are the limits applied to JSON-RPC requests, which can be changed while the node is
+// running.
+type RPCLimits struct {
+	// GasCap is the global gas cap for eth-call variants.
+	GasCap uint64
+	// EVMTimeout is the global timeout for eth-call.
+	EVMTimeout time.Duration
+	// TxFeeCap is the global transaction fee(price * gaslimit) cap for send-transaction
+	// variants. The unit is ether.
+	TxFeeCap float64
+}
+
 // backend represents the backend for the JSON-RPC service.
 type backend struct {
 	polar               *Polaris
 	cfg                 *Config
+	rpcLimits           atomic.Pointer[RPCLimits]
 	extRPCEnabled       bool
 	allowUnprotectedTxs bool
 	hostChainVersion    string
@@ -110,6 +172,10 @@ func NewAPIBackend(
 		logger:              log.Root(),
 	}
 
+	b.rpcLimits.Store(&RPCLimits{
+		GasCap: cfg.RPCGasCap, EVMTimeout: cfg.RPCEVMTimeout, TxFeeCap: cfg.RPCTxFeeCap,
+	})
+
 	if cfg.GPO.Default == nil {
 		panic("cfg.GPO.Default is nil")
 	}
@@ -153,9 +219,15 @@ func (b *backend) FeeHistory(
 	return b.gpo.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
 }
 
-// ChainDb is unused in Polaris.
+// ChainDb returns the database that rejected blocks are stored in, which is the only part of
+// geth's chain database that Polaris maintains.
 func (b *backend) ChainDb() ethdb.Database { //nolint:stylecheck // conforms to interface.
-	return ethdb.Database(nil)
+	return b.polar.blockchain.BadBlockDB()
+}
+
+// BadBlocks returns the most recently rejected blocks.
+func (b *backend) BadBlocks() []*pcore.BadBlock {
+	return b.polar.blockchain.BadBlocks()
 }
 
 // AccountManager is unused in Polaris.
@@ -172,17 +244,17 @@ func (b *backend) ExtRPCEnabled() bool {
 // RPCGasCap returns the global gas cap for eth_call over rpc: this is
 // if the user doesn't specify a cap.
 func (b *backend) RPCGasCap() uint64 {
-	return b.cfg.RPCGasCap
+	return b.rpcLimits.Load().GasCap
 }
 
 // RPCEVMTimeout returns the global timeout for eth_call over rpc.
 func (b *backend) RPCEVMTimeout() time.Duration {
-	return b.cfg.RPCEVMTimeout
+	return b.rpcLimits.Load().EVMTimeout
 }
 
 // RPCTxFeeCap returns the global gas price cap for transactions over rpc.
 func (b *backend) RPCTxFeeCap() float64 {
-	return b.cfg.RPCTxFeeCap
+	return b.rpcLimits.Load().TxFeeCap
 }
 
 // UnprotectedAllowed returns whether unprotected transactions are alloweds.
@@ -195,9 +267,26 @@ func (b *backend) UnprotectedAllowed() bool {
 // ==============================================================================
 
 // SetHead is used for state sync on ethereum, we leave state sync up to the host
-// chain and thus it is not implemented in Polaris.
-func (b *backend) SetHead(_ uint64) {
-	panic("not implemented")
+// chain and thus the head cannot be rewound while the node is running. The reason is only
+// logged, as debug_setHead is served by the debug API, which returns it as an error.
+func (b *backend) SetHead(number uint64) {
+	if err := b.RewindHead(number); err != nil {
+		b.logger.Error("failed to set head", "number", number, "err", err)
+	}
+}
+
+// RewindHead returns why the head of the chain cannot be rewound to the given block. The head
+// is determined by the host chain's consensus, so the host chain must be rolled back with its
+// own tooling while the node is stopped.
+func (b *backend) RewindHead(number uint64) error {
+	if head := b.polar.blockchain.CurrentBlock().Number.Uint64(); number >= head {
+		return fmt.Errorf("%w: block %d is not before the current head %d",
+			errSetHeadUnsupported, number, head)
+	}
+	return fmt.Errorf(
+		"%w: stop the node and run the host chain's rollback command to revert to block %d",
+		errSetHeadUnsupported, number,
+	)
 }
 
 func (b *backend) HeaderByNumber(
@@ -229,9 +318,14 @@ func (b *backend) HeaderByNumber(
 		}
 		return nil, errors.New("safe block not found")
 	case rpc.EarliestBlockNumber:
-		return b.polar.blockchain.GetHeaderByNumber(0), nil
+		return b.polar.blockchain.GetHeaderByNumber(b.polar.blockchain.EarliestBlockNumber()), nil
 	default:
-		return b.polar.blockchain.GetHeaderByNumber(uint64(number)), nil
+		// the headers of pruned blocks are still served while the host chain has them.
+		header := b.polar.blockchain.GetHeaderByNumber(uint64(number))
+		if header == nil {
+			return nil, b.checkHistoryAvailable(uint64(number))
+		}
+		return header, nil
 	}
 }
 
@@ -250,7 +344,11 @@ func (b *backend) HeaderByNumberOrHash(ctx context.Context,
 
 // HeaderByHash returns the block header with the given hash.
 func (b *backend) HeaderByHash(_ context.Context, hash common.Hash) (*ethtypes.Header, error) {
-	return b.polar.blockchain.GetHeaderByHash(hash), nil
+	header := b.polar.blockchain.GetHeaderByHash(hash)
+	if header == nil {
+		return nil, b.checkHashAvailable(hash)
+	}
+	return header, nil
 }
 
 // BlockByNumber returns the block with the given `number`.
@@ -285,19 +383,52 @@ func (b *backend) BlockByNumber(
 		return b.polar.blockchain.GetBlock(header.Hash(), header.Number.Uint64()), nil
 
 	case rpc.EarliestBlockNumber:
-		return b.polar.blockchain.GetBlockByNumber(0), nil
+		return b.polar.blockchain.GetBlockByNumber(b.polar.blockchain.EarliestBlockNumber()), nil
 	}
 	// safe to assume number > 0
+	if err := b.checkHistoryAvailable(uint64(number)); err != nil {
+		return nil, err
+	}
 	return b.polar.blockchain.GetBlockByNumber(uint64(number)), nil
 }
 
+// EarliestBlockNumber returns the number of the earliest block whose historical data has not been
+// pruned.
+func (b *backend) EarliestBlockNumber() uint64 {
+	return b.polar.blockchain.EarliestBlockNumber()
+}
+
+// checkHistoryAvailable returns an error if the historical data of the given block has been
+// pruned. The genesis block is always available.
+func (b *backend) checkHistoryAvailable(number uint64) error {
+	if earliest := b.polar.blockchain.EarliestBlockNumber(); number > 0 && number < earliest {
+		return fmt.Errorf(
+			"%w: block %d is before the earliest available block %d",
+			pcore.ErrHistoryPruned, number, earliest,
+		)
+	}
+	return nil
+}
+
+// checkHashAvailable returns an error if the historical data of the block or transaction with the
+// given hash has been pruned.
+func (b *backend) checkHashAvailable(hash common.Hash) error {
+	if b.polar.blockchain.HistoryPruned(hash) {
+		return fmt.Errorf(
+			"%w: %s is before the earliest available block %d",
+			pcore.ErrHistoryPruned, hash.Hex(), b.polar.blockchain.EarliestBlockNumber(),
+		)
+	}
+	return nil
+}
+
 // BlockByHash returns the block with the given `hash`.
 func (b *backend) BlockByHash(_ context.Context, hash common.Hash) (*ethtypes.Block, error) {
 	block := b.polar.blockchain.GetBlockByHash(hash)
 	b.logger.Debug("BlockByHash", "hash", hash, "block", block)
 	if block == nil {
 		b.logger.Error("eth.rpc.backend.BlockByHash", "hash", hash, "nil", true)
-		return nil, nil //nolint:nilnil // to match geth.
+		return nil, b.checkHashAvailable(hash)
 	}
 	b.logger.Debug("called eth.rpc.backend.BlockByHash", "header", block.Header(),
 		"num_txs", len(block.Transactions()))
@@ -314,6 +445,9 @@ func (b *backend) BlockByNumberOrHash(
 	if hash, ok := blockNrOrHash.Hash(); ok {
 		block := b.polar.blockchain.GetBlockByHash(hash)
 		if block == nil {
+			if err := b.checkHashAvailable(hash); err != nil {
+				return nil, err
+			}
 			return nil, pcore.ErrBlockNotFound
 		}
 		// if blockNrOrHash.RequireCanonical &&
@@ -405,7 +539,7 @@ func (b *backend) GetTransaction(
 	b.logger.Debug("called eth.rpc.backend.GetTransaction", "tx_hash", txHash)
 	txLookup := b.polar.blockchain.GetTransactionLookup(txHash)
 	if txLookup == nil {
-		return nil, common.Hash{}, 0, 0, nil
+		return nil, common.Hash{}, 0, 0, b.checkHashAvailable(txHash)
 	}
 	return txLookup.Tx, txLookup.BlockHash, txLookup.BlockNum, txLookup.TxIndex, nil
 }
@@ -427,13 +561,27 @@ func (b *backend) PendingBlockAndReceipts() (*ethtypes.Block, ethtypes.Receipts)
 
 // GetReceipts returns the receipts for the given block hash.
 func (b *backend) GetReceipts(_ context.Context, hash common.Hash) (ethtypes.Receipts, error) {
-	return b.polar.blockchain.GetReceiptsByHash(hash), nil
+	receipts := b.polar.blockchain.GetReceiptsByHash(hash)
+	if receipts == nil {
+		return nil, b.checkHashAvailable(hash)
+	}
+	return receipts, nil
+}
+
+// GetBlobSidecars returns the blob sidecars of the blob transactions in the given block hash.
+func (b *backend) GetBlobSidecars(
+	_ context.Context, hash common.Hash,
+) ([]*ethtypes.BlobTxSidecar, error) {
+	return b.polar.blockchain.GetBlobSidecarsByHash(hash), nil
 }
 
 // GetLogs returns the logs for the given block hash or number.
 func (b *backend) GetLogs(
 	_ context.Context, blockHash common.Hash, number uint64,
 ) ([][]*ethtypes.Log, error) {
+	if err := b.checkHistoryAvailable(number); err != nil {
+		return nil, err
+	}
 	receipts := b.polar.blockchain.GetReceiptsByHash(blockHash)
 	logs := make([][]*ethtypes.Log, len(receipts))
 	for i, receipt := range receipts {
@@ -465,9 +613,8 @@ func (b *backend) GetEVM(_ context.Context, msg *core.Message,
 	if blockCtx != nil {
 		context = *blockCtx
 	} else {
-		// TODO: we are hardcoding author to coinbase, this may be incorrect.
-		// TODO: Suggestion -> implement Engine.Author() and allow host chain to decide.
-		context = core.NewEVMBlockContext(header, b.polar.Blockchain(), &header.Coinbase)
+		// The author of the block is decided by the consensus engine.
+		context = core.NewEVMBlockContext(header, b.polar.Blockchain(), nil)
 	}
 	return vm.NewEVM(context, txContext, state, b.polar.blockchain.Config(),
 		*vmConfig)
@@ -477,9 +624,8 @@ func (b *backend) GetEVM(_ context.Context, msg *core.Message,
 func (b *backend) GetBlockContext(
 	_ context.Context, header *ethtypes.Header,
 ) *vm.BlockContext {
-	// TODO: we are hardcoding author to coinbase, this may be incorrect.
-	// TODO: Suggestion -> implement Engine.Author() and allow host chain to decide.
-	blockContext := core.NewEVMBlockContext(header, b.polar.Blockchain(), &header.Coinbase)
+	// The author of the block is decided by the consensus engine.
+	blockContext := core.NewEVMBlockContext(header, b.polar.Blockchain(), nil)
 	return &blockContext
 }
 
@@ -503,7 +649,18 @@ func (b *backend) SubscribeChainSideEvent(ch chan<- core.ChainSideEvent) event.S
 // ==============================================================================
 
 func (b *backend) SendTx(_ context.Context, signedTx *ethtypes.Transaction) error {
-	return b.polar.txPool.Add([]*ethtypes.Transaction{signedTx}, true, false)[0]
+	if b.polar.txValidator != nil {
+		if err := b.polar.txValidator.ValidateTx(signedTx); err != nil {
+			return err
+		}
+	}
+	if err := b.polar.txPool.Add([]*ethtypes.Transaction{signedTx}, true, false)[0]; err != nil {
+		return err
+	}
+	if b.polar.txValidator != nil {
+		b.polar.txValidator.RecordTx(signedTx)
+	}
+	return nil
 }
 
 func (b *backend) GetPoolTransactions() (ethtypes.Transactions, error) {
@@ -557,6 +714,60 @@ func (b *backend) TxPoolContentFrom(addr common.Address) (
 	return pending, queued
 }
 
+// TxPoolStatus returns the status of the transaction with the given hash in the txpool.
+func (b *backend) TxPoolStatus(hash common.Hash) txpool.TxStatus {
+	return b.polar.txPool.Status(hash)
+}
+
+// HostTxStatus returns the host chain's view of the transaction with the given hash, or nil if
+// the host chain does not provide one.
+func (b *backend) HostTxStatus(hash common.Hash) *polarapi.HostTxStatus {
+	if b.polar.txStatus == nil {
+		return nil
+	}
+	return b.polar.txStatus.TxStatus(hash)
+}
+
+// TxCosmosEvents returns the Cosmos events emitted by precompiles during the execution of the
+// transaction with the given hash.
+func (b *backend) TxCosmosEvents(hash common.Hash) ([]*polarapi.CosmosEvent, error) {
+	if b.polar.cosmosEvents == nil {
+		return nil, errCosmosEventsUnavailable
+	}
+	return b.polar.cosmosEvents.TxCosmosEvents(hash)
+}
+
+// SubscribeCosmosEvents subscribes to the Cosmos events emitted in each block of the host chain.
+func (b *backend) SubscribeCosmosEvents(
+	ch chan<- *polarapi.CosmosBlockEvents,
+) (event.Subscription, error) {
+	if b.polar.subscriptions == nil {
+		return nil, errSubscriptionsUnavailable
+	}
+	return b.polar.subscriptions.SubscribeCosmosEvents(ch), nil
+}
+
+// SubscribeValidatorSetChanges subscribes to the changes of the host chain's validator set.
+func (b *backend) SubscribeValidatorSetChanges(
+	ch chan<- *polarapi.RPCValidatorSetChange,
+) (event.Subscription, error) {
+	if b.polar.subscriptions == nil {
+		return nil, errSubscriptionsUnavailable
+	}
+	return b.polar.subscriptions.SubscribeValidatorSetChanges(ch), nil
+}
+
+// SubscribeProposalStatus subscribes to the status changes of the host chain's governance
+// proposals.
+func (b *backend) SubscribeProposalStatus(
+	ch chan<- *polarapi.RPCProposalStatus,
+) (event.Subscription, error) {
+	if b.polar.subscriptions == nil {
+		return nil, errSubscriptionsUnavailable
+	}
+	return b.polar.subscriptions.SubscribeProposalStatus(ch), nil
+}
+
 func (b *backend) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Subscription {
 	return b.polar.txPool.SubscribeTransactions(ch, true)
 }
@@ -577,6 +788,9 @@ func (b *backend) GetBody(
 	}
 	block, err := b.BlockByNumberOrHash(
 		ctx, rpc.BlockNumberOrHash{BlockNumber: &number, BlockHash: &hash})
+	if errors.Is(err, pcore.ErrHistoryPruned) {
+		return nil, err
+	}
 	if block == nil || err != nil {
 		b.logger.Error("eth.rpc.backend.GetBody", "number", number, "hash", hash, "err", err)
 		return nil, nil //nolint:nilnil // to match geth.
@@ -33,17 +33,21 @@ import (
 	"github.com/ethereum/go-ethereum/cmd/utils"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/beacon"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/txpool/blobpool"
 	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/ethapi"
+	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/miner"
 	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	// To ensure that tracer engines get loaded in.
@@ -55,6 +59,15 @@ import (
 // abstracted away networking stack, by extension we will need to improve the registration
 // architecture.
 
+const (
+	// badBlockDBCache and badBlockDBHandles are the cache size in MB and the number of file
+	// handles of the bad block database, which is small and rarely written to.
+	badBlockDBCache   = 16
+	badBlockDBHandles = 16
+	// badBlockDBNamespace is the metrics namespace of the bad block database.
+	badBlockDBNamespace = "polaris/badblocks/"
+)
+
 var defaultEthConfig = ethconfig.Config{
 	SyncMode:           0,
 	FilterLogCacheSize: 0,
@@ -75,12 +88,22 @@ type Polaris struct {
 	host       core.PolarisHostChain
 	blockchain core.Blockchain
 	txPool     *txpool.TxPool
+	// legacyPool is the legacy subpool of the txpool.
+	legacyPool *legacypool.LegacyPool
 	miner      *miner.Miner
+	// minerChainConfig is the copy of the chain config the miner builds blocks with.
+	minerChainConfig *params.ChainConfig
 
 	// apiBackend is utilize by the api handlers as a middleware between the
 	// JSON-RPC APIs and the core pieces.
-	apiBackend APIBackend
-	syncStatus SyncStatusProvider
+	apiBackend  APIBackend
+	syncStatus  SyncStatusProvider
+	txValidator TxValidator
+	txStatus    TxStatusProvider
+	// cosmosEvents provides the Cosmos events emitted by precompiles.
+	cosmosEvents CosmosEventsProvider
+	// subscriptions provides the results of the blocks finalized by the host chain.
+	subscriptions SubscriptionProvider
 
 	// engine represents the consensus engine for the backend.
 	engine consensus.Engine
@@ -108,11 +131,24 @@ func New(
 		engine = beacon.New(&consensus.DummyEthOne{})
 	}
 
+	// Open the database that rejected blocks are stored in, if they are persisted.
+	var badBlockDB ethdb.Database
+	if config.BadBlocksDatadir != "" {
+		var err error
+		if badBlockDB, err = rawdb.NewLevelDBDatabase(
+			config.BadBlocksDatadir, badBlockDBCache, badBlockDBHandles, badBlockDBNamespace, false,
+		); err != nil {
+			panic(err)
+		}
+	}
+
+	minerChainConfig := config.Chain
 	pl := &Polaris{
-		config:     config,
-		host:       host,
-		engine:     engine,
-		blockchain: core.NewChain(host, &config.Chain, engine),
+		config:           config,
+		host:             host,
+		engine:           engine,
+		blockchain:       core.NewChain(host, &config.Chain, engine, badBlockDB),
+		minerChainConfig: &minerChainConfig,
 	}
 
 	// Build the backend api object.
@@ -124,24 +160,27 @@ func New(
 	// with development configs.
 	pl.config.SafetyMessage()
 
-	// For now, we only have a legacy pool, we will implement blob pool later.
-	legacyPool := legacypool.New(
+	// Setup the legacy and blob (EIP-4844) subpools.
+	pl.legacyPool = legacypool.New(
 		pl.config.LegacyTxPool, pl.Blockchain(),
 	)
+	blobPool := blobpool.New(
+		pl.config.BlobPool, pl.Blockchain(),
+	)
 
-	// Setup the transaction pool and attach the legacyPool.
+	// Setup the transaction pool and attach the subpools.
 	var err error
 	if pl.txPool, err = txpool.New(
 		new(big.Int).SetUint64(pl.config.LegacyTxPool.PriceLimit),
 		pl.blockchain,
-		[]txpool.SubPool{legacyPool},
+		[]txpool.SubPool{pl.legacyPool, blobPool},
 	); err != nil {
 		panic(err)
 	}
 
 	// Setup the miner, we use a dummy isLocal function, since it is not used.
 	pl.miner = miner.New(pl, &pl.config.Miner,
-		&pl.config.Chain, stack.EventMux(), pl.engine,
+		pl.minerChainConfig, stack.EventMux(), pl.engine,
 		func(header *ethtypes.Header) bool { return true },
 	)
 
@@ -176,6 +215,14 @@ func (pl *Polaris) APIs() []rpc.API {
 	// Grab a bunch of the apis from go-Polaris (thx bae)
 	apis := ethapi.GetAPIs(pl.apiBackend, pl.blockchain)
 
+	// Override eth_getTransactionReceipt to include the Cosmos events of transactions, if enabled.
+	if pl.config.RPCReceiptCosmosEvents {
+		apis = append(apis, rpc.API{
+			Namespace: "eth",
+			Service:   polarapi.NewReceiptAPI(pl.apiBackend),
+		})
+	}
+
 	// Append all the local APIs and return
 	return append(apis, []rpc.API{
 		{
@@ -189,11 +236,22 @@ func (pl *Polaris) APIs() []rpc.API {
 			),
 		},
 		{
-			// NOTE: endpoints that require tracing "bad blocks" are currently not supported
-			// (debug_traceBadBlock, debug_intermediateRoots, debug_standardTraceBadBlockToFile)
+			Namespace: "eth",
+			Service:   polarapi.NewSimulateAPI(pl.apiBackend),
+		},
+		{
+			Namespace: "polaris",
+			Service:   polarapi.NewPolarisAPI(pl.apiBackend),
+		},
+		{
+			// Bad blocks are read from the chain database, which stores rejected blocks.
 			Namespace: "debug",
 			Service:   tracers.NewAPI(pl.apiBackend),
 		},
+		{
+			Namespace: "debug",
+			Service:   polarapi.NewDebugAPI(pl.apiBackend),
+		},
 	}...)
 }
 
@@ -204,6 +262,62 @@ func (pl *Polaris) RegisterSyncStatusProvider(
 	pl.syncStatus = syncStatus
 }
 
+// RegisterTxValidator registers a validator that is consulted before transactions submitted over
+// JSON-RPC are added to the txpool, and told about the ones that the txpool accepts.
+func (pl *Polaris) RegisterTxValidator(
+	txValidator TxValidator,
+) {
+	pl.txValidator = txValidator
+}
+
+// RegisterTxStatusProvider registers a provider of the host chain's view of transactions, which
+// is surfaced over JSON-RPC for debugging.
+func (pl *Polaris) RegisterTxStatusProvider(
+	txStatus TxStatusProvider,
+) {
+	pl.txStatus = txStatus
+}
+
+// RegisterCosmosEventsProvider registers a provider of the Cosmos events emitted by precompiles
+// during the execution of transactions, which are surfaced over JSON-RPC.
+func (pl *Polaris) RegisterCosmosEventsProvider(
+	cosmosEvents CosmosEventsProvider,
+) {
+	pl.cosmosEvents = cosmosEvents
+}
+
+// RegisterSubscriptionProvider registers a provider of the results of the blocks finalized by the
+// host chain, which are streamed over the polaris_subscribe WebSocket subscriptions.
+func (pl *Polaris) RegisterSubscriptionProvider(
+	subscriptions SubscriptionProvider,
+) {
+	pl.subscriptions = subscriptions
+}
+
+// SetRPCLimits replaces the limits applied to JSON-RPC requests. It is safe to call while the
+// node is running.
+func (pl *Polaris) SetRPCLimits(limits RPCLimits) {
+	if b, ok := pl.apiBackend.(*backend); ok {
+		b.rpcLimits.Store(&limits)
+	}
+}
+
+// SetAccountSlots sets the number of executable transaction slots guaranteed per account by the
+// legacy txpool, which applies it from its next reorg on. It is safe to call while the node is
+// running.
+func (pl *Polaris) SetAccountSlots(slots uint64) error {
+	return setLegacyPoolAccountSlots(pl.legacyPool, slots)
+}
+
+// SetChainConfig replaces the chain config of the blockchain and of the miner. The miner keeps
+// its own copy of the config, which is overwritten, so it must only be called while no payload is
+// being built. The legacy txpool keeps the config it was started with, which only differs in the
+// time based hardforks, whose rules are enforced when the blocks are built and processed.
+func (pl *Polaris) SetChainConfig(cfg *params.ChainConfig) {
+	pl.blockchain.SetConfig(cfg)
+	*pl.minerChainConfig = *cfg
+}
+
 // Host returns the Polaris host chain.
 func (pl *Polaris) Host() core.PolarisHostChain {
 	return pl.host
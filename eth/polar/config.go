@@ -30,6 +30,7 @@ import (
 	"github.com/berachain/polaris/eth/params"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/txpool/blobpool"
 	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
 	"github.com/ethereum/go-ethereum/eth/gasprice"
@@ -46,6 +47,18 @@ const (
 	// DO NOT USE IN PRODUCTION.
 	// 0xf8637fa70e8e329ecb8463b788d96914f8cfe191d15ae36f161227629e3f5693.
 	developmentCoinbase = "0xAf15f95bed0D3913a29092Fd7837451Ce4de64D3"
+
+	// defaultBlobSidecarRetention is the default number of blocks for which blob sidecars are
+	// retained, which mirrors the 4096 epochs of 32 slots that beacon nodes retain blobs for.
+	defaultBlobSidecarRetention = 4096 * 32
+
+	// defaultPriceLimit is the default minimum gas price of the txpool and the miner, which is
+	// low to handle the low base fee. The txpool's price limit must not exceed the miner's.
+	defaultPriceLimit = 8
+
+	// defaultHistoricalDBBackend is the default backend for historical data, a node-local
+	// leveldb database.
+	defaultHistoricalDBBackend = "leveldb"
 )
 
 // DefaultConfig returns the default JSON-RPC config.
@@ -55,20 +68,25 @@ func DefaultConfig() *Config {
 	gpoConfig.MaxPrice = big.NewInt(ethparams.GWei * 10000) //nolint:gomnd // default.
 	minerCfg := miner.DefaultConfig
 	minerCfg.Etherbase = common.HexToAddress(developmentCoinbase)
-	minerCfg.GasPrice = big.NewInt(1)
+	minerCfg.GasPrice = new(big.Int).SetUint64(defaultPriceLimit)
 	legacyPool := legacypool.DefaultConfig
 	legacyPool.NoLocals = true
-	legacyPool.PriceLimit = 8 // to handle the low base fee.
+	legacyPool.PriceLimit = defaultPriceLimit
 	legacyPool.Journal = ""
+	blobPool := blobpool.DefaultConfig
+	blobPool.Datadir = ""
 
 	return &Config{
-		Chain:         *params.DefaultChainConfig,
-		Miner:         minerCfg,
-		GPO:           gpoConfig,
-		LegacyTxPool:  legacyPool,
-		RPCGasCap:     ethconfig.Defaults.RPCGasCap,
-		RPCTxFeeCap:   ethconfig.Defaults.RPCTxFeeCap,
-		RPCEVMTimeout: ethconfig.Defaults.RPCEVMTimeout,
+		Chain:                *params.DefaultChainConfig,
+		Miner:                minerCfg,
+		GPO:                  gpoConfig,
+		LegacyTxPool:         legacyPool,
+		BlobPool:             blobPool,
+		BlobSidecarRetention: defaultBlobSidecarRetention,
+		HistoricalDB:         HistoricalDBConfig{Backend: defaultHistoricalDBBackend},
+		RPCGasCap:            ethconfig.Defaults.RPCGasCap,
+		RPCTxFeeCap:          ethconfig.Defaults.RPCTxFeeCap,
+		RPCEVMTimeout:        ethconfig.Defaults.RPCEVMTimeout,
 	}
 }
 
@@ -96,6 +114,19 @@ type Config struct {
 	// Transaction pool options
 	LegacyTxPool legacypool.Config
 
+	// Blob transaction pool options
+	BlobPool blobpool.Config
+
+	// BlobSidecarRetention is the number of blocks for which blob sidecars are retained.
+	BlobSidecarRetention uint64
+
+	// Historical data database options
+	HistoricalDB HistoricalDBConfig
+
+	// BadBlocksDatadir is the directory of the database that rejected blocks are stored in for
+	// debugging. Rejected blocks are only kept in memory if it is empty.
+	BadBlocksDatadir string
+
 	// RPCGasCap is the global gas cap for eth-call variants.
 	RPCGasCap uint64
 
@@ -105,4 +136,27 @@ type Config struct {
 	// RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for
 	// send-transaction variants. The unit is ether.
 	RPCTxFeeCap float64
+
+	// RPCReceiptCosmosEvents includes the Cosmos events emitted by precompiles in
+	// eth_getTransactionReceipt responses.
+	RPCReceiptCosmosEvents bool
+}
+
+// HistoricalDBConfig represents the configuration of the database that historical blocks,
+// receipts and transaction lookups are stored in.
+type HistoricalDBConfig struct {
+	// Backend is the database backend. Historical data is node-local, so it is stored in
+	// Datadir by "leveldb" or "pebble", or kept in memory by "memory", e.g. in tests.
+	Backend string
+
+	// Datadir is the directory of the database, which every backend but "memory" requires.
+	Datadir string
+
+	// RetainBlocks is the number of most recent blocks whose historical data is retained in the
+	// database. Zero retains every block.
+	RetainBlocks uint64
+
+	// RetainAge is how long the historical data of a block is retained in the database. Zero
+	// retains blocks regardless of their age.
+	RetainAge time.Duration
 }
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package polar
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"unsafe"
+
+	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
+)
+
+// errUnsupportedLegacyPool is returned when the legacy txpool does not have the expected layout.
+var errUnsupportedLegacyPool = errors.New("unsupported legacy txpool, expected a config and lock")
+
+// setLegacyPoolAccountSlots sets the account slots in the config of the given legacy txpool. The
+// legacy txpool has no setter for its config, whose account slots it only reads under its lock
+// when it truncates the pending transactions, so the config is written through reflection while
+// holding that lock.
+func setLegacyPoolAccountSlots(pool *legacypool.LegacyPool, slots uint64) error {
+	if slots < 1 {
+		return errors.New("account slots must be positive")
+	}
+	v := reflect.ValueOf(pool).Elem()
+	mu, cfg := v.FieldByName("mu"), v.FieldByName("config")
+	if !mu.IsValid() || mu.Type() != reflect.TypeOf(sync.RWMutex{}) ||
+		!cfg.IsValid() || cfg.Type() != reflect.TypeOf(legacypool.Config{}) {
+		return errUnsupportedLegacyPool
+	}
+
+	//#nosec:G103 unsafe pointers are safe here since the types of the fields are checked above.
+	lock := (*sync.RWMutex)(unsafe.Pointer(mu.UnsafeAddr()))
+	lock.Lock()
+	defer lock.Unlock()
+	//#nosec:G103 unsafe pointers are safe here since the types of the fields are checked above.
+	(*legacypool.Config)(unsafe.Pointer(cfg.UnsafeAddr())).AccountSlots = slots
+	return nil
+}
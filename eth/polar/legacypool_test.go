@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package polar
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
+	"github.com/ethereum/go-ethereum/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPolar(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "eth/polar")
+}
+
+// chainConfig is a legacy txpool chain that only has a chain config.
+type chainConfig struct {
+	legacypool.BlockChain
+}
+
+func (chainConfig) Config() *params.ChainConfig { return params.TestChainConfig }
+
+var _ = Describe("setLegacyPoolAccountSlots", func() {
+	var pool *legacypool.LegacyPool
+
+	// accountSlots returns the account slots in the config of the pool.
+	accountSlots := func() uint64 {
+		return reflect.ValueOf(pool).Elem().FieldByName("config").
+			FieldByName("AccountSlots").Uint()
+	}
+
+	BeforeEach(func() {
+		pool = legacypool.New(legacypool.DefaultConfig, chainConfig{})
+	})
+
+	It("should set the account slots of the legacy txpool", func() {
+		Expect(accountSlots()).To(Equal(legacypool.DefaultConfig.AccountSlots))
+		Expect(setLegacyPoolAccountSlots(pool, 4)).To(Succeed())
+		Expect(accountSlots()).To(Equal(uint64(4)))
+	})
+
+	It("should reject zero account slots", func() {
+		Expect(setLegacyPoolAccountSlots(pool, 0)).ToNot(Succeed())
+		Expect(accountSlots()).To(Equal(legacypool.DefaultConfig.AccountSlots))
+	})
+})